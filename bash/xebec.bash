@@ -0,0 +1,48 @@
+# ============================================
+#  BASH – XEBEC CORPORATION
+#  Generado por xebec: {{ .FragmentPath }}
+# ============================================
+
+# Solo en sesiones interactivas
+[[ $- == *i* ]] || return 0
+{{ if .Modules.prompt }}
+# Prompt XEBEC (Starship lo reemplaza si está inicializado)
+if ! command -v starship >/dev/null 2>&1; then
+  PS1='\[\e[1;{{ sgr .Palette.blue }}m\]\W\[\e[0m\] \[\e[{{ sgr .Palette.green }}m\]❯\[\e[0m\] '
+fi
+{{ end }}{{ if .Modules.colors }}
+# Colores XEBEC para ls
+export LS_COLORS='{{ .LSColors }}'
+{{ end }}{{ if .Modules.aliases }}
+# eza en lugar de ls
+if command -v eza >/dev/null 2>&1; then
+  alias ls='eza --group-directories-first --icons=auto'
+  alias ll='eza -l --git --group-directories-first --icons=auto'
+  alias la='eza -la --git --group-directories-first --icons=auto'
+  alias lt='eza --tree --level=2 --icons=auto'
+else
+  alias ls='ls --color=auto'
+  alias ll='ls -lh --color=auto'
+  alias la='ls -lha --color=auto'
+fi
+
+# bat en lugar de cat (Debian/Ubuntu lo instalan como batcat)
+if command -v batcat >/dev/null 2>&1 && ! command -v bat >/dev/null 2>&1; then
+  alias bat='batcat'
+fi
+if command -v bat >/dev/null 2>&1 || command -v batcat >/dev/null 2>&1; then
+  alias cat='bat --paging=never --style=plain'
+fi
+{{ end }}{{ if .Modules.fzf }}
+# fzf: Ctrl+R historial, Ctrl+T archivos, Alt+C directorios
+export FZF_DEFAULT_OPTS="--height=40% --layout=reverse --border --color={{ .FzfColors }}"
+if command -v fzf >/dev/null 2>&1; then
+  if fzf --bash >/dev/null 2>&1; then
+    eval "$(fzf --bash)"
+  else
+    for f in /usr/share/doc/fzf/examples/key-bindings.bash /usr/share/fzf/key-bindings.bash; do
+      [ -r "$f" ] && . "$f" && break
+    done
+  fi
+fi
+{{ end }}
@@ -0,0 +1,256 @@
+// Package: commands
+// Manifiesto de perfil: xebec plan, xebec apply y xebec.lock
+// author: XebecCorporation
+// version: 1.0.0
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/actions"
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	manifestFile    string
+	applyUpdateLock bool
+)
+
+// planCmd muestra lo que cambiaría al aplicar el manifiesto
+var planCmd = &cobra.Command{
+	Use:   "plan [-f xebec.yaml]",
+	Short: "Muestra qué cambiaría al aplicar un manifiesto",
+	Long: `Compara el manifiesto (xebec.yaml, xebec.toml o xebec.json) con esta máquina
+y muestra qué se instalaría, configuraría o cambiaría sin tocar nada.
+Si hay un xebec.lock junto al manifiesto, también muestra lo que no coincide con él.
+Sin -f se busca el manifiesto en el directorio actual.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		profile, path, err := loadProfile()
+		if err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+		locked, ok, err := actions.LoadLock(actions.GetLockPath(path))
+		if err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+		plan, err := actions.BuildPlan(profile, locked)
+		if err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+		printPlan(plan)
+		if !ok {
+			return
+		}
+		current, err := actions.BuildLock(profile, version)
+		if err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+		fmt.Println()
+		printLockMismatches(actions.VerifyLock(locked, current))
+	},
+}
+
+// applyCmd aplica el manifiesto
+var applyCmd = &cobra.Command{
+	Use:   "apply [-f xebec.yaml] [--yes] [--update-lock]",
+	Short: "Configura esta máquina según un manifiesto",
+	Long: `Aplica un manifiesto compartido: instala las herramientas y la fuente que falten,
+configura terminales y shells con sus secciones y activa el tema. Solo se
+ejecutan los pasos que no coinciden con el estado actual (ver xebec plan).
+
+Después escribe xebec.lock junto al manifiesto con las versiones instaladas,
+su origen y los hashes de plantillas y tema. Si el lock ya existía, las
+herramientas se instalan con su versión (winget, scoop y dnf la fijan) y una
+herramienta instalada con otra versión detiene el plan; al terminar informa
+de lo que no coincide y solo añade lo que faltaba. --update-lock acepta las
+versiones instaladas y regenera el lock con lo de esta máquina.
+
+Los terminales y shells que no están instalados se omiten con un aviso.
+Termina con código 1 si algún paso falla o algo no coincide con el lock.`,
+	Example: `  xebec plan -f xebec.yaml
+  xebec apply -f xebec.yaml --yes
+  xebec apply --update-lock`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		profile, path, err := loadProfile()
+		if err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+		lockPath := actions.GetLockPath(path)
+		locked, hasLock, err := actions.LoadLock(lockPath)
+		if err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+		// Con --update-lock las versiones instaladas reemplazan a las del lock
+		pinned := locked
+		if applyUpdateLock {
+			pinned = actions.Lock{}
+		}
+		plan, err := actions.BuildPlan(profile, pinned)
+		if err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+
+		printPlan(plan)
+		var applyErr error
+		if plan.HasChanges() {
+			fmt.Println()
+			if err := requireConfirmation(fmt.Sprintf("¿Aplicar %s?", path)); err != nil {
+				fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+				os.Exit(1)
+			}
+			applyErr = actions.ApplyPlan(plan)
+		}
+
+		mismatches, err := writeLock(profile, lockPath, locked, hasLock && !applyUpdateLock)
+		if err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+		if applyErr != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", applyErr)))
+			os.Exit(1)
+		}
+		if len(mismatches) > 0 {
+			fmt.Println(ui.MutedTextStyle.Render("Si los cambios son intencionados: xebec apply --update-lock"))
+			os.Exit(1)
+		}
+		if plan.HasChanges() {
+			fmt.Println(ui.RenderSuccess("Manifiesto aplicado"))
+		}
+	},
+}
+
+// lockCmd regenera xebec.lock con lo instalado en esta máquina
+var lockCmd = &cobra.Command{
+	Use:   "lock [-f xebec.yaml]",
+	Short: "Regenera xebec.lock sin aplicar el manifiesto",
+	Long: `Escribe xebec.lock junto al manifiesto con las versiones y el origen de las
+herramientas instaladas en esta máquina y los hashes de plantillas y tema.
+Las herramientas registradas desde otros sistemas se conservan.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		profile, path, err := loadProfile()
+		if err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+		lockPath := actions.GetLockPath(path)
+		locked, _, err := actions.LoadLock(lockPath)
+		if err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+		if _, err := writeLock(profile, lockPath, locked, false); err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+	},
+}
+
+// loadProfile lee el manifiesto de -f (o del directorio actual) y lo resuelve para este sistema
+func loadProfile() (actions.ManifestProfile, string, error) {
+	path := manifestFile
+	if path == "" {
+		dir, err := os.Getwd()
+		if err != nil {
+			return actions.ManifestProfile{}, "", err
+		}
+		if path, err = actions.FindManifest(dir); err != nil {
+			return actions.ManifestProfile{}, "", err
+		}
+	}
+	manifest, err := actions.LoadManifest(path)
+	if err != nil {
+		return actions.ManifestProfile{}, path, err
+	}
+	return manifest.Profile(runtime.GOOS), path, nil
+}
+
+// writeLock toma la instantánea de esta máquina y la guarda en el lock
+// Con verify compara antes con el lock existente y solo añade lo que falte
+func writeLock(profile actions.ManifestProfile, lockPath string, locked actions.Lock, verify bool) ([]actions.LockMismatch, error) {
+	current, err := actions.BuildLock(profile, version)
+	if err != nil {
+		return nil, err
+	}
+	var mismatches []actions.LockMismatch
+	if verify {
+		mismatches = actions.VerifyLock(locked, current)
+		fmt.Println()
+		printLockMismatches(mismatches)
+	}
+	if err := actions.SaveLock(lockPath, actions.MergeLock(locked, current, !verify)); err != nil {
+		return mismatches, err
+	}
+	if !verify {
+		fmt.Println(ui.RenderSuccess(fmt.Sprintf("Lock actualizado: %s", lockPath)))
+	}
+	return mismatches, nil
+}
+
+// printLockMismatches muestra lo que no coincide con xebec.lock
+func printLockMismatches(mismatches []actions.LockMismatch) {
+	if len(mismatches) == 0 {
+		fmt.Println(ui.RenderSuccess(fmt.Sprintf("Coincide con %s", actions.LockName)))
+		return
+	}
+	fmt.Println(ui.WarningStyle.Render(fmt.Sprintf("⚠ No coincide con %s:", actions.LockName)))
+	for _, m := range mismatches {
+		fmt.Printf("  %s %s\n", ui.ErrorStyle.Render("✗"), m)
+	}
+}
+
+// printPlan imprime el plan al estilo de terraform plan
+func printPlan(plan actions.Plan) {
+	fmt.Println(ui.TitleStyle.Render(fmt.Sprintf("Plan (%s)", runtime.GOOS)))
+	for _, step := range plan.Steps {
+		var mark string
+		switch step.Action {
+		case actions.PlanInstall:
+			mark = ui.SuccessStyle.Render("+")
+		case actions.PlanConfigure, actions.PlanTheme:
+			mark = ui.WarningStyle.Render("~")
+		case actions.PlanSkip:
+			mark = ui.ErrorStyle.Render("!")
+		default:
+			mark = ui.MutedTextStyle.Render("=")
+		}
+		fmt.Printf("  %s %-9s %-18s %s\n", mark, step.Kind, step.Name, ui.MutedTextStyle.Render(step.Detail))
+	}
+
+	fmt.Println()
+	if !plan.HasChanges() {
+		fmt.Println(ui.RenderSuccess("Sin cambios: la máquina coincide con el manifiesto"))
+		return
+	}
+	summary := fmt.Sprintf("%d para instalar, %d para configurar", plan.Count(actions.PlanInstall), plan.Count(actions.PlanConfigure))
+	if plan.Count(actions.PlanTheme) > 0 {
+		summary += ", cambio de tema"
+	}
+	if skipped := plan.Count(actions.PlanSkip); skipped > 0 {
+		summary += fmt.Sprintf(", %d omitidos", skipped)
+	}
+	fmt.Println(ui.RenderInfo(summary))
+}
+
+func init() {
+	planCmd.Flags().StringVarP(&manifestFile, "file", "f", "", "Manifiesto (por defecto xebec.yaml en el directorio actual)")
+	applyCmd.Flags().StringVarP(&manifestFile, "file", "f", "", "Manifiesto (por defecto xebec.yaml en el directorio actual)")
+	applyCmd.Flags().BoolVarP(&assumeYes, "yes", "y", false, "No preguntar (necesario sin terminal)")
+	applyCmd.Flags().BoolVar(&actions.ForceConfigWrite, "force", false, "Escribir la configuración aunque no pase la validación")
+	applyCmd.Flags().BoolVar(&applyUpdateLock, "update-lock", false, "Regenerar xebec.lock con lo de esta máquina")
+	lockCmd.Flags().StringVarP(&manifestFile, "file", "f", "", "Manifiesto (por defecto xebec.yaml en el directorio actual)")
+}
@@ -0,0 +1,109 @@
+// Package: commands
+// Diagnóstico del entorno: xebec doctor
+// author: XebecCorporation
+// version: 1.0.0
+
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/actions"
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var doctorFix bool
+
+// doctorCmd comprueba el entorno y propone cómo arreglar cada problema
+var doctorCmd = &cobra.Command{
+	Use:   "doctor [--fix]",
+	Short: "Comprueba el entorno y sugiere cómo arreglarlo",
+	Long: `Comprueba que el entorno configurado por XEBEC funciona:
+
+  - el shell que abren Alacritty y WezTerm existe
+  - JetBrains Mono está instalada
+  - el terminal anuncia color de 24 bits
+  - ~/.local/bin está en el PATH
+  - alacritty.toml, starship.toml y settings.json se pueden leer
+  - la versión de Alacritty entiende su configuración
+  - cada shell inicializa Starship
+
+Con --fix aplica los arreglos seguros (apuntar al shell instalado, instalar la
+fuente, añadir ~/.local/bin al PATH, inicializar Starship) y vuelve a comprobar.
+Termina con código 1 si queda alguna comprobación fallida.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		checks := actions.RunDoctor()
+		printDoctorChecks(checks)
+
+		if doctorFix {
+			fixed := 0
+			for _, check := range checks {
+				if !check.Fixable() {
+					continue
+				}
+				fmt.Println()
+				fmt.Println(ui.RenderInfo(fmt.Sprintf("Arreglando: %s", check.Name)))
+				if err := check.Fix(); err != nil {
+					fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+					continue
+				}
+				fixed++
+			}
+			if fixed > 0 {
+				fmt.Println()
+				checks = actions.RunDoctor()
+				printDoctorChecks(checks)
+			}
+		}
+
+		for _, check := range checks {
+			if check.Status == actions.DoctorFail {
+				os.Exit(1)
+			}
+		}
+	},
+}
+
+// printDoctorChecks imprime cada comprobación con su arreglo y un resumen
+func printDoctorChecks(checks []actions.DoctorCheck) {
+	fmt.Println(ui.TitleStyle.Render("🩺 Diagnóstico"))
+	counts := map[string]int{}
+	fixable := 0
+	for _, check := range checks {
+		counts[check.Status]++
+		var mark string
+		switch check.Status {
+		case actions.DoctorPass:
+			mark = ui.SuccessStyle.Render("✓")
+		case actions.DoctorWarn:
+			mark = ui.WarningStyle.Render("⚠")
+		default:
+			mark = ui.ErrorStyle.Render("✗")
+		}
+		fmt.Printf("  %s %-32s %s\n", mark, check.Name, ui.MutedTextStyle.Render(check.Detail))
+		if check.Status != actions.DoctorPass && check.Remedy != "" {
+			fmt.Printf("    %s\n", ui.MutedTextStyle.Render("→ "+check.Remedy))
+		}
+		if check.Fixable() {
+			fixable++
+		}
+	}
+
+	fmt.Println()
+	summary := fmt.Sprintf("%d correctas, %d avisos, %d fallos", counts[actions.DoctorPass], counts[actions.DoctorWarn], counts[actions.DoctorFail])
+	if fixable > 0 && !doctorFix {
+		summary += fmt.Sprintf(" (%d se arreglan con xebec doctor --fix)", fixable)
+	}
+	if counts[actions.DoctorFail] > 0 {
+		fmt.Println(ui.RenderError(summary))
+	} else {
+		fmt.Println(ui.RenderInfo(summary))
+	}
+}
+
+func init() {
+	doctorCmd.Flags().BoolVar(&doctorFix, "fix", false, "Aplicar los arreglos seguros")
+}
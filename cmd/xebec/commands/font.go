@@ -0,0 +1,64 @@
+// Package: commands
+// Instalación de la fuente de las plantillas
+// author: XebecCorporation
+// version: 1.0.0
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/actions"
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	fontArchive string
+	fontForce   bool
+)
+
+// installFontCmd instala JetBrains Mono en el directorio de fuentes del usuario
+var installFontCmd = &cobra.Command{
+	Use:   "font",
+	Short: "Instala la fuente JetBrains Mono que usan las plantillas",
+	Long: `Detecta si JetBrains Mono está instalada (fc-list en Linux, registro de
+Windows o directorios de fuentes) y, si no lo está, la instala en el directorio
+de fuentes del usuario y actualiza la caché.
+
+Por defecto descarga la versión publicada por JetBrains; XEBEC_FONT_URL apunta
+a un espejo del mismo .zip y --archive instala desde un .zip local. En todos los
+casos se verifica el SHA-256 del .zip antes de extraerlo.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := installXebecFont(); err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+	},
+}
+
+// installXebecFont instala JetBrains Mono salvo que ya esté instalada (o se pida --force)
+func installXebecFont() error {
+	font := actions.XebecFont
+	if where, ok := actions.DetectFont(font.Family); ok && !fontForce {
+		fmt.Println(ui.RenderSuccess(fmt.Sprintf("%s ya está instalada (%s)", font.Family, where)))
+		return nil
+	}
+
+	files, err := actions.InstallFont(font, fontArchive)
+	if err != nil {
+		return err
+	}
+	fmt.Println(ui.RenderSuccess(fmt.Sprintf("%s %s instalada: %d archivos en %s", font.Family, font.Version, len(files), filepath.Dir(files[0]))))
+	fmt.Println(ui.MutedTextStyle.Render("Reinicia el terminal para usar la fuente"))
+	return nil
+}
+
+func init() {
+	installFontCmd.Flags().StringVarP(&fontArchive, "archive", "a", "", "Instalar desde un .zip local en lugar de descargarlo")
+	installFontCmd.Flags().BoolVar(&fontForce, "force", false, "Reinstalar aunque ya esté instalada")
+	installCmd.AddCommand(installFontCmd)
+}
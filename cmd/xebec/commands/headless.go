@@ -0,0 +1,245 @@
+// Package: commands
+// Modo no interactivo: configuradores y acciones del menú desde la línea de comandos
+// author: XebecCorporation
+// version: 1.0.0
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/actions"
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/ui"
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+)
+
+var configSections []string
+
+// configAliases atajos históricos de "xebec config"
+var configAliases = map[string][]string{
+	"terminal": {"alacritty"},
+	"shell":    {"nushell", "starship"},
+}
+
+// configCmd configura herramientas sin pasar por el menú
+var configCmd = &cobra.Command{
+	Use:   "config <herramienta>... [--sections a,b] [--yes]",
+	Short: "Configura componentes del ecosistema XEBEC",
+	Long: `Aplica configuraciones XEBEC a terminales, shells y Starship sin abrir el menú.
+
+Cada herramienta tiene las mismas secciones que sus casillas en el menú; sin
+--sections se aplican todas (en los grupos excluyentes, la primera). Con
+--yes no se pregunta nada, así que funciona sin terminal (scripts, CI).
+Termina con código 1 si algo falla.
+
+Herramientas: ` + strings.Join(actions.GetConfiguratorIDs(), ", ") + `
+Atajos: terminal (alacritty), shell (nushell y starship)`,
+	Example: `  xebec config alacritty --sections colors,font --yes
+  xebec config starship --sections git,layout_two_line
+  xebec config kitty --sections list`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var targets []actions.Configurator
+		for _, arg := range args {
+			ids, ok := configAliases[arg]
+			if !ok {
+				ids = []string{arg}
+			}
+			for _, id := range ids {
+				c, found := actions.FindConfigurator(id)
+				if !found {
+					fmt.Println(ui.RenderError(fmt.Sprintf("Herramienta desconocida: %s (disponibles: %s)", id, strings.Join(actions.GetConfiguratorIDs(), ", "))))
+					os.Exit(1)
+				}
+				targets = append(targets, c)
+			}
+		}
+		if len(targets) > 1 && len(configSections) > 0 {
+			fmt.Println(ui.RenderError("--sections solo se puede usar con una herramienta"))
+			os.Exit(1)
+		}
+
+		failed := 0
+		for _, c := range targets {
+			if err := runConfigurator(c, configSections); err != nil {
+				fmt.Println(ui.RenderError(fmt.Sprintf("%s: %v", c.Name, err)))
+				failed++
+			}
+		}
+		if failed > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+// runCmd ejecuta cualquier acción del menú por su ID
+var runCmd = &cobra.Command{
+	Use:   "run <acción> [--sections a,b] [--yes]",
+	Short: "Ejecuta una acción del menú interactivo sin abrirlo",
+	Long: `Ejecuta una opción del menú por su ID (terminal_alacritty, shell_nushell,
+tools_fzf, backup...). Los configuradores aceptan --sections como "xebec config".
+Sin argumentos lista las acciones disponibles.`,
+	Example: `  xebec run terminal_alacritty --sections colors,font --yes
+  xebec run tools_all --yes
+  xebec run backup`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			printMenuActions()
+			return
+		}
+		if err := runMenuAction(args[0], configSections); err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+	},
+}
+
+// runMenuAction ejecuta una acción del menú de forma no interactiva
+func runMenuAction(id string, sections []string) error {
+	if c, ok := actions.FindConfigurator(id); ok {
+		return runConfigurator(c, sections)
+	}
+	if len(sections) > 0 {
+		return fmt.Errorf("%s no tiene secciones", id)
+	}
+	if tool, ok := actions.FindTool(id); ok {
+		if err := requireConfirmation(fmt.Sprintf("¿Instalar %s con el gestor de paquetes del sistema?", tool.Name)); err != nil {
+			return err
+		}
+		return actions.InstallTool(tool)
+	}
+
+	switch id {
+	case "tools_all":
+		return installAllTools()
+	case "tools_font":
+		if err := requireConfirmation(fmt.Sprintf("¿Instalar la fuente %s?", actions.XebecFont.Family)); err != nil {
+			return err
+		}
+		return installXebecFont()
+	case "terminal_list", "terminal_refresh":
+		ui.ShowTerminalsTable()
+	case "status":
+		ui.ShowStatus()
+	case "theme":
+		return printThemeList()
+	case "backup":
+		_, err := actions.BackupConfigured()
+		return err
+	case "restore":
+		if err := requireConfirmation("Se restaurará el último backup de cada herramienta configurada. ¿Continuar?"); err != nil {
+			return err
+		}
+		_, err := actions.RestoreConfigured()
+		return err
+	default:
+		return fmt.Errorf("acción desconocida: %s (lista: xebec run)", id)
+	}
+	return nil
+}
+
+// runConfigurator aplica un configurador con las secciones indicadas
+// "list" como sección muestra las disponibles sin aplicar nada
+func runConfigurator(c actions.Configurator, sections []string) error {
+	if len(sections) == 1 && sections[0] == "list" {
+		printSections(c)
+		return nil
+	}
+
+	ids, err := c.ResolveSections(sections)
+	if err != nil {
+		return err
+	}
+	if installed, _, _ := c.Status(); !installed {
+		return fmt.Errorf("%s no está instalado", c.Name)
+	}
+
+	fmt.Println(ui.RenderInfo(fmt.Sprintf("%s: %s", c.Name, strings.Join(ids, ", "))))
+	if err := requireConfirmation(fmt.Sprintf("¿Aplicar la configuración de %s?", c.Name)); err != nil {
+		return err
+	}
+	if err := c.Apply(ids); err != nil {
+		return err
+	}
+	fmt.Println(ui.RenderSuccess(fmt.Sprintf("%s configurado", c.Name)))
+	return nil
+}
+
+// installAllTools instala todas las herramientas tras confirmar (xebec install tools y xebec run tools_all)
+func installAllTools() error {
+	if err := requireConfirmation("¿Instalar todas las herramientas con el gestor de paquetes del sistema?"); err != nil {
+		return err
+	}
+	fmt.Println(ui.RenderInfo("Instalando herramientas..."))
+	return actions.InstallTools(actions.Tools)
+}
+
+// requireConfirmation pide confirmación; con --yes no pregunta y sin terminal exige --yes
+func requireConfirmation(question string) error {
+	if assumeYes {
+		return nil
+	}
+	if !isInteractive() {
+		return fmt.Errorf("no hay terminal interactiva para confirmar; usa --yes")
+	}
+	if !confirm(question) {
+		return fmt.Errorf("cancelado")
+	}
+	return nil
+}
+
+// isInteractive indica si la entrada estándar es una terminal
+func isInteractive() bool {
+	fd := os.Stdin.Fd()
+	return isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd)
+}
+
+// printSections lista las secciones de un configurador
+func printSections(c actions.Configurator) {
+	fmt.Println(ui.TitleStyle.Render(c.Name))
+	for _, opt := range c.Options() {
+		group := ""
+		if opt.Group != "" {
+			group = ui.MutedTextStyle.Render(" (" + opt.Group + ")")
+		}
+		fmt.Printf("  %-18s %s%s\n", opt.ID, opt.Description, group)
+	}
+}
+
+// printMenuActions lista los IDs de las acciones del menú
+func printMenuActions() {
+	fmt.Println(ui.TitleStyle.Render("Acciones del menú"))
+	for _, opt := range ui.GetMenuOptions() {
+		if opt.ID == "exit" {
+			continue
+		}
+		if !opt.Submenu {
+			fmt.Printf("  %-22s %s\n", opt.ID, opt.Description)
+			continue
+		}
+		fmt.Println()
+		fmt.Println(ui.HighlightStyle.Render(opt.Title))
+		for _, sub := range ui.GetSubmenu(opt.ID) {
+			if sub.ID == "back" {
+				continue
+			}
+			fmt.Printf("  %-22s %s\n", sub.ID, sub.Description)
+		}
+	}
+}
+
+func init() {
+	actions.NonInteractive = !isInteractive()
+
+	configCmd.Flags().StringSliceVarP(&configSections, "sections", "s", nil, "Secciones a aplicar separadas por comas (list para verlas)")
+	configCmd.Flags().BoolVarP(&assumeYes, "yes", "y", false, "No preguntar (necesario sin terminal)")
+	configCmd.Flags().BoolVar(&actions.ForceConfigWrite, "force", false, "Escribir la configuración aunque no pase la validación")
+
+	runCmd.Flags().StringSliceVarP(&configSections, "sections", "s", nil, "Secciones del configurador separadas por comas")
+	runCmd.Flags().BoolVarP(&assumeYes, "yes", "y", false, "No preguntar (necesario sin terminal)")
+	runCmd.Flags().BoolVar(&actions.ForceConfigWrite, "force", false, "Escribir la configuración aunque no pase la validación")
+}
@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/actions"
+)
+
+// withoutTTY sustituye stdin por un archivo normal, como en CI
+func withoutTTY(t *testing.T) {
+	t.Helper()
+	f, err := os.Open(os.DevNull)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := os.Stdin
+	os.Stdin = f
+	t.Cleanup(func() {
+		os.Stdin = saved
+		f.Close()
+	})
+}
+
+func setAssumeYes(t *testing.T, value bool) {
+	t.Helper()
+	saved := assumeYes
+	assumeYes = value
+	t.Cleanup(func() { assumeYes = saved })
+}
+
+// fakeConfigurator sustituye los configuradores por uno falso que guarda sus secciones
+func fakeConfigurator(t *testing.T, applied *[][]string) {
+	t.Helper()
+	saved := actions.Configurators
+	actions.Configurators = []actions.Configurator{{
+		ID: "alacritty", MenuID: "terminal_alacritty", Name: "Alacritty",
+		Options: func() []actions.ConfigOption {
+			return []actions.ConfigOption{{ID: "colors"}, {ID: "font"}}
+		},
+		Status: func() (bool, bool, string) { return true, true, "" },
+		Apply:  func(ids []string) error { *applied = append(*applied, ids); return nil },
+	}}
+	t.Cleanup(func() { actions.Configurators = saved })
+}
+
+func TestRequireConfirmationWithoutTTY(t *testing.T) {
+	withoutTTY(t)
+
+	setAssumeYes(t, false)
+	err := requireConfirmation("¿Continuar?")
+	if err == nil || !strings.Contains(err.Error(), "--yes") {
+		t.Errorf("sin terminal y sin --yes err = %v, want que pida --yes", err)
+	}
+
+	setAssumeYes(t, true)
+	if err := requireConfirmation("¿Continuar?"); err != nil {
+		t.Errorf("con --yes err = %v", err)
+	}
+}
+
+func TestRunMenuAction(t *testing.T) {
+	withoutTTY(t)
+	var applied [][]string
+	fakeConfigurator(t, &applied)
+
+	tests := []struct {
+		name     string
+		id       string
+		sections []string
+		yes      bool
+		wantErr  string
+		want     [][]string
+	}{
+		{name: "configurador por MenuID", id: "terminal_alacritty", sections: []string{"font"}, yes: true, want: [][]string{{"font"}}},
+		{name: "configurador sin secciones aplica todas", id: "alacritty", yes: true, want: [][]string{{"colors", "font"}}},
+		{name: "list no aplica", id: "alacritty", sections: []string{"list"}},
+		{name: "sección desconocida", id: "alacritty", sections: []string{"shell"}, yes: true, wantErr: "shell"},
+		{name: "configurador sin --yes", id: "alacritty", wantErr: "--yes"},
+		{name: "herramienta sin --yes", id: "tools_fzf", wantErr: "--yes"},
+		{name: "todas las herramientas sin --yes", id: "tools_all", wantErr: "--yes"},
+		{name: "restore sin --yes", id: "restore", wantErr: "--yes"},
+		{name: "secciones en una acción", id: "backup", sections: []string{"colors"}, yes: true, wantErr: "no tiene secciones"},
+		{name: "acción desconocida", id: "nope", yes: true, wantErr: "acción desconocida"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applied = nil
+			setAssumeYes(t, tt.yes)
+			err := runMenuAction(tt.id, tt.sections)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("runMenuAction(%s) err = %v, want %q", tt.id, err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Errorf("runMenuAction(%s): %v", tt.id, err)
+			}
+			if !reflect.DeepEqual(applied, tt.want) {
+				t.Errorf("Apply recibió %v, want %v", applied, tt.want)
+			}
+		})
+	}
+}
+
+func TestInstallAllToolsRequiresYes(t *testing.T) {
+	withoutTTY(t)
+	setAssumeYes(t, false)
+	// xebec install tools y xebec run tools_all comparten la confirmación
+	if err := installAllTools(); err == nil || !strings.Contains(err.Error(), "--yes") {
+		t.Errorf("installAllTools() sin --yes err = %v, want que pida --yes", err)
+	}
+	if err := installCmd.Flags().Parse([]string{"--yes"}); err != nil || !assumeYes {
+		t.Errorf("xebec install tools no acepta --yes: %v", err)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/actions"
 	"github.com/XebecCorporation/XebecCorporation.Dots/internal/ui"
 	"github.com/spf13/cobra"
 )
@@ -24,7 +25,12 @@ configurar y mantener el ecosistema XEBEC CORPORATION.
 Ejemplos de uso:
   xebec              - Inicia el menú interactivo
   xebec config       - Configura componentes
+  xebec config alacritty --sections colors,font --yes - Sin menú ni preguntas
+  xebec run tools_fzf --yes - Ejecuta una acción del menú
   xebec install      - Instala herramientas
+  xebec apply -f xebec.yaml - Configura la máquina según un manifiesto
+  xebec shell set-default nu - Fija Nushell como shell por defecto
+  xebec theme export -f kitty - Exporta el tema a otro formato
   xebec version      - Muestra la versión`,
 	Version: version,
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -43,11 +49,22 @@ func Execute() error {
 
 func init() {
 	// Add subcommands
+	rootCmd.AddCommand(applyCmd)
 	rootCmd.AddCommand(configCmd)
+	rootCmd.AddCommand(doctorCmd)
 	rootCmd.AddCommand(installCmd)
+	rootCmd.AddCommand(lockCmd)
+	rootCmd.AddCommand(planCmd)
+	rootCmd.AddCommand(runCmd)
+	rootCmd.AddCommand(shellCmd)
+	rootCmd.AddCommand(statusCmd)
+	rootCmd.AddCommand(terminalsCmd)
+	rootCmd.AddCommand(themeCmd)
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(interactiveCmd)
 
+	installCmd.Flags().BoolVarP(&assumeYes, "yes", "y", false, "No preguntar (necesario sin terminal)")
+
 	// Configure root command
 	rootCmd.SetOut(os.Stdout)
 	rootCmd.SetErr(os.Stderr)
@@ -55,6 +72,8 @@ func init() {
 
 // runInteractiveMenu ejecuta el menú interactivo
 func runInteractiveMenu() error {
+	ui.ApplyTheme(actions.XebecTheme())
+
 	// Mostrar banner
 	ui.ShowBanner()
 	fmt.Println()
@@ -63,55 +82,30 @@ func runInteractiveMenu() error {
 	return ui.RunMenu(version)
 }
 
-// configCmd handles configuration of tools
-var configCmd = &cobra.Command{
-	Use:   "config [terminal|shell]",
-	Short: "Configura componentes del ecosistema XEBEC",
-	Long:  `Aplica configuraciones XEBEC a terminal, shell y herramientas.`,
-	Args:  cobra.MaximumNArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		if len(args) == 0 {
-			fmt.Println(ui.RenderInfo("Usa uno de los subcomandos: terminal, shell"))
-			fmt.Println()
-			fmt.Println("  xebec config terminal  - Configura Alacritty")
-			fmt.Println("  xebec config shell    - Configura Nushell + Starship")
-			return
-		}
-
-		switch args[0] {
-		case "terminal":
-			fmt.Println(ui.RenderInfo("Configurando Alacritty..."))
-			// TODO: Implementar configuración de terminal
-		case "shell":
-			fmt.Println(ui.RenderInfo("Configurando Nushell + Starship..."))
-			// TODO: Implementar configuración de shell
-		default:
-			fmt.Println(ui.RenderError(fmt.Sprintf("Componente desconocido: %s", args[0])))
-		}
-	},
-}
-
 // installCmd handles installation of tools
 var installCmd = &cobra.Command{
-	Use:   "install [tools]",
+	Use:   "install [tools|font]",
 	Short: "Instala herramientas del ecosistema XEBEC",
-	Long:  `Instala herramientas según el sistema operativo detectado.`,
+	Long: `Instala herramientas según el sistema operativo detectado.
+
+Pide confirmación antes de usar el gestor de paquetes, como "xebec run tools_all";
+sin terminal hace falta --yes.`,
 	Args:  cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		if len(args) == 0 {
-			fmt.Println(ui.RenderInfo("Usa: xebec install tools"))
+			fmt.Println(ui.RenderInfo("Usa: xebec install tools | xebec install font"))
 			return
 		}
 
 		switch args[0] {
 		case "tools":
-			fmt.Println(ui.RenderInfo("Instalando herramientas..."))
-			// Detectar sistema
-			sysInfo := ui.DetectSystem()
-			fmt.Println(ui.NormalTextStyle.Render("Sistema detectado: " + sysInfo.String()))
-			// TODO: Implementar instalación de herramientas
+			if err := installAllTools(); err != nil {
+				fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+				os.Exit(1)
+			}
 		default:
 			fmt.Println(ui.RenderError(fmt.Sprintf("Comando desconocido: %s", args[0])))
+			os.Exit(1)
 		}
 	},
 }
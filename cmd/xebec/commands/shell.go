@@ -0,0 +1,62 @@
+// Package: commands
+// Comandos de gestión de shells
+// author: XebecCorporation
+// version: 1.0.0
+
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/actions"
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var assumeYes bool
+
+// shellCmd agrupa los comandos de shells
+var shellCmd = &cobra.Command{
+	Use:   "shell",
+	Short: "Gestiona los shells del sistema",
+	Long:  `Comandos para gestionar los shells soportados por XEBEC.`,
+}
+
+// shellSetDefaultCmd cambia el shell por defecto
+var shellSetDefaultCmd = &cobra.Command{
+	Use:   "set-default <shell>",
+	Short: "Fija el shell por defecto (nu, zsh, bash, fish, powershell)",
+	Long: `En Linux y macOS valida el shell contra /etc/shells (ofreciendo registrarlo)
+y ejecuta chsh. En Windows actualiza el perfil por defecto de Windows Terminal
+y el shell de Alacritty y WezTerm para que todos los terminales coincidan.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := actions.SetDefaultShell(args[0], confirm); err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	shellSetDefaultCmd.Flags().BoolVarP(&assumeYes, "yes", "y", false, "Responder sí a todas las preguntas")
+	shellSetDefaultCmd.Flags().BoolVar(&actions.ForceConfigWrite, "force", false, "Escribir la configuración aunque no pase la validación")
+	shellCmd.AddCommand(shellSetDefaultCmd)
+}
+
+// confirm pregunta sí/no por la terminal (respeta --yes)
+func confirm(question string) bool {
+	if assumeYes {
+		return true
+	}
+	fmt.Printf("%s [s/N] ", question)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "s", "si", "sí", "y", "yes":
+		return true
+	}
+	return false
+}
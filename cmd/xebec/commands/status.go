@@ -0,0 +1,233 @@
+// Package: commands
+// Estado del sistema y drift de los archivos gestionados: xebec status y xebec terminals
+// author: XebecCorporation
+// version: 1.0.0
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/actions"
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	statusDrift  bool
+	statusDiff   bool
+	statusJSON   bool
+	outputFormat string
+)
+
+// statusCmd muestra el estado del sistema o el drift de los archivos gestionados
+var statusCmd = &cobra.Command{
+	Use:   "status [--drift] [--diff] [--output table|json|yaml] [ruta|herramienta...]",
+	Short: "Muestra el estado del sistema y de los archivos gestionados",
+	Long: `Sin opciones muestra el sistema, los terminales detectados y los archivos gestionados.
+
+Con --drift compara cada archivo (o bloque xebec:) con lo último que escribió
+XEBEC según el historial y con la plantilla actual:
+
+  in-sync            coincide con lo que escribió XEBEC
+  user-modified      editado a mano después
+  outdated-template  sin editar, pero la plantilla ha cambiado (vuelve a aplicar xebec config)
+  missing            el archivo o el bloque ya no existe
+
+--diff muestra las diferencias de cada archivo que no está sincronizado y
+los argumentos filtran por ruta o herramienta.
+
+Con --output json o yaml imprime el estado completo (sistema, tema, terminales,
+componentes configurados, versiones y archivos) con un esquema estable para
+scripts de inventario; con --drift, solo los archivos. --json equivale a --output json.`,
+	Example: `  xebec status --drift
+  xebec status --drift --diff alacritty
+  xebec status --output json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		format, err := resolveOutputFormat()
+		if err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+
+		if !statusDrift && !statusDiff {
+			if format == "table" {
+				ui.ShowStatus()
+				return
+			}
+			report, err := ui.BuildStatusReport(version)
+			if err == nil {
+				err = printOutput(format, report)
+			}
+			if err != nil {
+				fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+				os.Exit(1)
+			}
+			return
+		}
+
+		drifts, err := actions.DetectDrift()
+		if err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+		drifts = filterDrifts(drifts, args)
+
+		switch {
+		case format != "table":
+			if err := printDriftOutput(format, drifts, statusDiff); err != nil {
+				fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+				os.Exit(1)
+			}
+		case statusDiff:
+			printDriftDiffs(drifts)
+		default:
+			ui.ShowDriftTable(drifts)
+		}
+	},
+}
+
+// terminalsCmd lista los terminales detectados
+var terminalsCmd = &cobra.Command{
+	Use:   "terminals [--output table|json|yaml]",
+	Short: "Lista los terminales detectados",
+	Long: `Muestra los terminales soportados, si están instalados, su versión y su
+archivo de configuración. Con --output json o yaml la salida sigue el mismo
+esquema que "terminals" en xebec status --output json.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		format, err := resolveOutputFormat()
+		if err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+		if format == "table" {
+			ui.ShowTerminalsTable()
+			return
+		}
+		if err := printOutput(format, ui.ReportTerminals()); err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+	},
+}
+
+// resolveOutputFormat valida --output (y su atajo --json)
+func resolveOutputFormat() (string, error) {
+	if statusJSON {
+		return "json", nil
+	}
+	switch outputFormat {
+	case "table", "json", "yaml":
+		return outputFormat, nil
+	case "yml":
+		return "yaml", nil
+	}
+	return "", fmt.Errorf("formato de salida desconocido: %s (table, json o yaml)", outputFormat)
+}
+
+// printOutput imprime v como JSON o YAML
+func printOutput(format string, v any) error {
+	var data []byte
+	var err error
+	if format == "yaml" {
+		data, err = actions.MarshalYAML(v)
+	} else {
+		data, err = json.MarshalIndent(v, "", "  ")
+	}
+	if err != nil {
+		return fmt.Errorf("error generando %s: %w", format, err)
+	}
+	fmt.Println(strings.TrimSuffix(string(data), "\n"))
+	return nil
+}
+
+// filterDrifts deja los archivos cuya ruta o herramienta coincide con algún argumento
+func filterDrifts(drifts []actions.FileDrift, args []string) []actions.FileDrift {
+	if len(args) == 0 {
+		return drifts
+	}
+	var out []actions.FileDrift
+	for _, d := range drifts {
+		for _, arg := range args {
+			abs, _ := filepath.Abs(arg)
+			if d.Tool == arg || d.Path == arg || d.Path == abs {
+				out = append(out, d)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// driftJSON entrada de xebec status --drift --output json|yaml
+type driftJSON struct {
+	actions.FileDrift
+	Diff string `json:"diff,omitempty"`
+}
+
+// printDriftOutput imprime el drift como JSON o YAML, con el diff de cada archivo si withDiff
+func printDriftOutput(format string, drifts []actions.FileDrift, withDiff bool) error {
+	out := make([]driftJSON, 0, len(drifts))
+	for _, d := range drifts {
+		entry := driftJSON{FileDrift: d}
+		if withDiff && d.Status != actions.DriftInSync {
+			diff, err := d.Diff()
+			if err != nil {
+				return err
+			}
+			entry.Diff = diff
+		}
+		out = append(out, entry)
+	}
+	return printOutput(format, out)
+}
+
+// printDriftDiffs muestra qué cambió en cada archivo desde que XEBEC lo escribió
+func printDriftDiffs(drifts []actions.FileDrift) {
+	shown := false
+	for _, d := range drifts {
+		switch d.Status {
+		case actions.DriftInSync:
+			continue
+		case actions.DriftOutdatedTemplate:
+			shown = true
+			fmt.Println(ui.WarningStyle.Render(fmt.Sprintf("🔄 %s: sin editar, la plantilla cambió (xebec config %s)", d.Label(), d.Tool)))
+			continue
+		}
+		diff, err := d.Diff()
+		if err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			continue
+		}
+		shown = true
+		for _, line := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+			switch {
+			case len(line) > 0 && line[0] == '@':
+				fmt.Println(ui.MutedTextStyle.Render(line))
+			case len(line) > 0 && line[0] == '+':
+				fmt.Println(ui.SuccessStyle.Render(line))
+			case len(line) > 0 && line[0] == '-':
+				fmt.Println(ui.ErrorStyle.Render(line))
+			default:
+				fmt.Println(line)
+			}
+		}
+		fmt.Println()
+	}
+	if !shown {
+		fmt.Println(ui.RenderSuccess("Todos los archivos coinciden con lo que escribió XEBEC"))
+	}
+}
+
+func init() {
+	statusCmd.Flags().BoolVar(&statusDrift, "drift", false, "Comparar los archivos gestionados con lo que escribió XEBEC")
+	statusCmd.Flags().BoolVar(&statusDiff, "diff", false, "Mostrar las diferencias de los archivos no sincronizados")
+	statusCmd.Flags().BoolVar(&statusJSON, "json", false, "Atajo de --output json")
+	statusCmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "Formato de salida: table, json o yaml")
+	terminalsCmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "Formato de salida: table, json o yaml")
+}
@@ -0,0 +1,347 @@
+// Package: commands
+// Comandos de gestión de temas
+// author: XebecCorporation
+// version: 1.0.0
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/actions"
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	themeExportFormat string
+	themeExportOutput string
+	themeExportTheme  string
+	themeImportFormat string
+	themeImportName   string
+	themeImportForce  bool
+	themeAppearance   string
+	themeLintStrict   bool
+)
+
+// themeCmd agrupa los comandos de temas
+var themeCmd = &cobra.Command{
+	Use:   "theme",
+	Short: "Gestiona el tema de colores XEBEC",
+	Long: `Comandos para trabajar con los temas de XEBEC (themes/xebec.json es el canónico):
+16 colores ANSI, fondo, texto, cursor, selección y acentos de la interfaz.
+Los temas importados y el tema activo se guardan junto a los datos de XEBEC.
+
+Cada tema tiene variante clara y oscura; si falta una se deriva de la otra.`,
+}
+
+// themeListCmd lista los temas instalados
+var themeListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lista los temas instalados",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := printThemeList(); err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+	},
+}
+
+// printThemeList imprime los temas instalados marcando el activo
+func printThemeList() error {
+	list, err := actions.ListThemes()
+	if err != nil {
+		return err
+	}
+	state, err := actions.LoadState()
+	if err != nil {
+		fmt.Println(ui.WarningStyle.Render(fmt.Sprintf("⚠ %v", err)))
+	}
+
+	fmt.Println(ui.TitleStyle.Render("🎨 Temas"))
+	fmt.Println()
+	for _, t := range list {
+		marker := " "
+		if t.Slug == state.Theme {
+			marker = "●"
+		}
+		origin := "importado"
+		if t.Builtin {
+			origin = "integrado"
+		}
+		fmt.Printf("  %s %-20s %-22s %-12s %s\n", marker, t.Slug, t.Name, strings.Join(t.Variants, ", "), ui.MutedTextStyle.Render(origin))
+	}
+	fmt.Println()
+	fmt.Printf("  Activo: %s (%s)\n", state.Theme, state.Appearance)
+	return nil
+}
+
+// themePreviewCmd muestra la vista previa de un tema
+var themePreviewCmd = &cobra.Command{
+	Use:   "preview [tema]",
+	Short: "Muestra una vista previa del tema (por defecto el activo)",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		theme := actions.XebecTheme()
+		if len(args) == 1 || themeAppearance != "" {
+			name, appearance, err := themeTarget(args)
+			if err == nil {
+				theme, err = actions.ResolveTheme(name, appearance)
+			}
+			if err != nil {
+				fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+				os.Exit(1)
+			}
+		}
+		fmt.Println(ui.RenderThemePreview(theme))
+		fmt.Println(ui.RenderThemeIssues(actions.LintTheme(theme)))
+	},
+}
+
+// themeSwitchCmd cambia el tema activo y lo reaplica en las herramientas configuradas
+var themeSwitchCmd = &cobra.Command{
+	Use:   "switch [tema] [--appearance dark|light|auto]",
+	Short: "Cambia el tema activo en todas las herramientas configuradas",
+	Long: `Activa el tema indicado y regenera en una sola operación los archivos de
+todas las herramientas que se configuraron con xebec, con las mismas opciones
+que se eligieron entonces.
+
+Sin tema se mantiene el activo (útil para cambiar solo la apariencia).
+Con --appearance auto se sigue la apariencia del sistema operativo cuando se
+puede detectar (macOS, Windows, GNOME/GTK); si no, se usa la oscura.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name, appearance, err := themeTarget(args)
+		if err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+
+		theme, err := actions.SwitchTheme(name, appearance)
+		if theme.Name == "" {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+		ui.ApplyTheme(theme)
+		printThemeWarnings(theme)
+		if err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			fmt.Println(ui.WarningStyle.Render(fmt.Sprintf("⚠ Tema %s (%s) activo; revisa las herramientas con error", theme.Name, theme.Variant)))
+			os.Exit(1)
+		}
+		fmt.Println(ui.RenderSuccess(fmt.Sprintf("Tema %s (%s) aplicado", theme.Name, theme.Variant)))
+	},
+}
+
+// themeLintCmd valida contraste y daltonismo de uno o varios temas
+var themeLintCmd = &cobra.Command{
+	Use:   "lint [tema|archivo.json...]",
+	Short: "Valida el contraste WCAG y la legibilidad con daltonismo",
+	Long: `Comprueba el contraste WCAG del texto sobre el fondo (mínimo 4.5:1), de cada
+color ANSI sobre el fondo (3:1), del cursor y de la selección, y simula
+deuteranopia y protanopia para avisar de colores que dejan de leerse o de
+rojos y verdes que no se distinguen.
+
+Acepta nombres de temas instalados o rutas a archivos JSON; sin argumentos
+valida todos los temas instalados en sus variantes. Termina con código 1 si
+hay errores (o avisos, con --strict), para usarlo en CI.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		targets, err := lintTargets(args)
+		if err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+
+		failed := 0
+		for _, theme := range targets {
+			issues := actions.LintTheme(theme)
+			fmt.Println(ui.HighlightStyle.Render(fmt.Sprintf("%s (%s)", theme.Name, theme.Variant)))
+			fmt.Println(ui.RenderThemeIssues(issues))
+			fmt.Println()
+			if actions.LintErrors(issues) > 0 || (themeLintStrict && len(issues) > 0) {
+				failed++
+			}
+		}
+		if failed > 0 {
+			fmt.Println(ui.RenderError(fmt.Sprintf("%d de %d temas no pasan la validación", failed, len(targets))))
+			os.Exit(1)
+		}
+	},
+}
+
+// lintTargets carga los temas a validar: archivos, nombres o todos los instalados
+func lintTargets(args []string) ([]actions.Theme, error) {
+	var targets []actions.Theme
+	if len(args) == 0 {
+		list, err := actions.ListThemes()
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range list {
+			for _, variant := range t.Variants {
+				theme, err := actions.ResolveTheme(t.Slug, variant)
+				if err != nil {
+					return nil, err
+				}
+				targets = append(targets, theme)
+			}
+		}
+		return targets, nil
+	}
+
+	for _, arg := range args {
+		if data, err := os.ReadFile(arg); err == nil {
+			theme, err := actions.ParseTheme(data)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", arg, err)
+			}
+			targets = append(targets, theme)
+			continue
+		}
+		name, appearance, err := themeTarget([]string{arg})
+		if err != nil {
+			return nil, err
+		}
+		theme, err := actions.ResolveTheme(name, appearance)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, theme)
+	}
+	return targets, nil
+}
+
+// printThemeWarnings muestra los problemas de legibilidad de un tema recién aplicado o importado
+func printThemeWarnings(theme actions.Theme) {
+	if issues := actions.LintTheme(theme); len(issues) > 0 {
+		fmt.Println(ui.RenderThemeIssues(issues))
+		fmt.Printf("  Detalle: xebec theme lint %s\n", actions.ThemeSlug(theme.Name))
+	}
+}
+
+// themeTarget completa el tema y la apariencia con el estado guardado
+func themeTarget(args []string) (string, string, error) {
+	state, err := actions.LoadState()
+	if err != nil {
+		return "", "", err
+	}
+	name, appearance := state.Theme, state.Appearance
+	if len(args) == 1 {
+		name = args[0]
+	}
+	if themeAppearance != "" {
+		appearance = themeAppearance
+	}
+	return name, appearance, nil
+}
+
+// themeExportCmd exporta el tema a otros formatos
+var themeExportCmd = &cobra.Command{
+	Use:   "export --format <formato> [-o archivo]",
+	Short: "Exporta el tema al formato de un terminal o herramienta",
+	Long: `Genera los colores del tema en el formato indicado y los escribe en la
+salida estándar o en el archivo de -o.
+
+Formatos: ` + strings.Join(actions.GetThemeExportFormats(), ", "),
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if themeExportFormat == "" {
+			fmt.Println(ui.RenderError("Indica el formato con --format"))
+			fmt.Println()
+			for _, e := range actions.GetThemeExporters() {
+				fmt.Printf("  %-18s %s\n", e.ID, e.Description)
+			}
+			os.Exit(1)
+		}
+
+		theme := actions.XebecTheme()
+		if themeExportTheme != "" {
+			var err error
+			if theme, err = actions.LoadTheme(themeExportTheme); err != nil {
+				fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+				os.Exit(1)
+			}
+		}
+
+		content, err := actions.ExportTheme(theme, themeExportFormat)
+		if err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+
+		if themeExportOutput == "" {
+			fmt.Print(content)
+			return
+		}
+		if err := os.MkdirAll(filepath.Dir(themeExportOutput), 0755); err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error creando directorio: %v", err)))
+			os.Exit(1)
+		}
+		if err := os.WriteFile(themeExportOutput, []byte(content), 0644); err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error escribiendo %s: %v", themeExportOutput, err)))
+			os.Exit(1)
+		}
+		fmt.Println(ui.RenderSuccess(fmt.Sprintf("Tema exportado: %s", themeExportOutput)))
+	},
+}
+
+// themeImportCmd importa un esquema de colores externo como tema con nombre
+var themeImportCmd = &cobra.Command{
+	Use:   "import <archivo>",
+	Short: "Importa un esquema de colores (base16, iTerm2, Gogh, Windows Terminal, Alacritty)",
+	Long: `Convierte un esquema externo al modelo de tema XEBEC y lo guarda como tema con
+nombre en el directorio de datos de XEBEC (themes/<nombre>.json).
+
+El formato se deduce de la extensión (.yaml, .itermcolors, .toml) y, en JSON,
+de sus claves; se puede forzar con --format. Los acentos de la interfaz se
+derivan de la paleta. No sobrescribe un tema existente ni reemplaza uno
+integrado salvo con --force.
+
+Formatos: ` + strings.Join(actions.ThemeImportFormats, ", "),
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		theme, err := actions.ImportTheme(args[0], themeImportFormat, themeImportName)
+		if err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+
+		path, err := actions.SaveTheme(theme, themeImportForce)
+		if err != nil {
+			fmt.Println(ui.RenderError(fmt.Sprintf("Error: %v", err)))
+			os.Exit(1)
+		}
+		fmt.Println(ui.RenderSuccess(fmt.Sprintf("Tema %s (%s) guardado: %s", theme.Name, theme.Variant, path)))
+		printThemeWarnings(theme)
+		fmt.Printf("  Exportar: xebec theme export --theme %s --format <formato>\n", actions.ThemeSlug(theme.Name))
+	},
+}
+
+func init() {
+	themeCmd.AddCommand(themeListCmd)
+
+	themePreviewCmd.Flags().StringVarP(&themeAppearance, "appearance", "a", "", "Apariencia: dark, light o auto")
+	themeCmd.AddCommand(themePreviewCmd)
+
+	themeSwitchCmd.Flags().StringVarP(&themeAppearance, "appearance", "a", "", "Apariencia: dark, light o auto (por defecto la guardada)")
+	themeSwitchCmd.Flags().BoolVar(&actions.ForceConfigWrite, "force", false, "Escribir la configuración aunque no pase la validación")
+	themeCmd.AddCommand(themeSwitchCmd)
+
+	themeLintCmd.Flags().StringVarP(&themeAppearance, "appearance", "a", "", "Apariencia de los temas indicados por nombre")
+	themeLintCmd.Flags().BoolVar(&themeLintStrict, "strict", false, "Falla también con avisos")
+	themeCmd.AddCommand(themeLintCmd)
+
+	themeExportCmd.Flags().StringVarP(&themeExportFormat, "format", "f", "", "Formato de salida")
+	themeExportCmd.Flags().StringVarP(&themeExportOutput, "output", "o", "", "Archivo de salida (por defecto la salida estándar)")
+	themeExportCmd.Flags().StringVarP(&themeExportTheme, "theme", "t", "", "Tema a exportar (por defecto el activo)")
+	themeCmd.AddCommand(themeExportCmd)
+
+	themeImportCmd.Flags().StringVarP(&themeImportFormat, "format", "f", "", "Formato del archivo (se deduce si se omite)")
+	themeImportCmd.Flags().StringVarP(&themeImportName, "name", "n", "", "Nombre del tema (por defecto el del esquema)")
+	themeImportCmd.Flags().BoolVar(&themeImportForce, "force", false, "Sobrescribir un tema existente o reemplazar uno integrado")
+	themeCmd.AddCommand(themeImportCmd)
+}
@@ -0,0 +1,60 @@
+# ============================================
+#  FISH – XEBEC CORPORATION
+#  Generado por xebec: {{ .FragmentPath }}
+# ============================================
+
+status is-interactive; or return
+{{ if .Modules.prompt }}
+# Prompt XEBEC (Starship lo reemplaza desde config.fish si está inicializado)
+if not type -q starship
+    function fish_prompt
+        set_color --bold {{ .Palette.blue }}
+        echo -n (prompt_pwd)
+        set_color {{ .Palette.green }}
+        echo -n ' ❯ '
+        set_color normal
+    end
+end
+{{ end }}{{ if .Modules.colors }}
+# Colores XEBEC para ls y la sintaxis de fish
+set -gx LS_COLORS '{{ .LSColors }}'
+set -g fish_color_normal {{ .Palette.foreground }}
+set -g fish_color_command {{ .Palette.blue }}
+set -g fish_color_param {{ .Palette.foreground }}
+set -g fish_color_quote {{ .Palette.green }}
+set -g fish_color_redirection {{ .Palette.yellow }}
+set -g fish_color_operator {{ .Palette.yellow }}
+set -g fish_color_end {{ .Palette.magenta }}
+set -g fish_color_error {{ .Palette.red }}
+set -g fish_color_comment {{ .Palette.gray }}
+set -g fish_color_autosuggestion {{ .Palette.gray }}
+set -g fish_color_valid_path --underline
+set -g fish_pager_color_prefix {{ .Palette.blue }}
+set -g fish_pager_color_description {{ .Palette.gray }}
+{{ end }}{{ if .Modules.aliases }}
+# eza en lugar de ls
+if type -q eza
+    alias ls 'eza --group-directories-first --icons=auto'
+    alias ll 'eza -l --git --group-directories-first --icons=auto'
+    alias la 'eza -la --git --group-directories-first --icons=auto'
+    alias lt 'eza --tree --level=2 --icons=auto'
+end
+
+# bat en lugar de cat (Debian/Ubuntu lo instalan como batcat)
+if type -q batcat; and not type -q bat
+    alias bat batcat
+end
+if type -q bat; or type -q batcat
+    alias cat 'bat --paging=never --style=plain'
+end
+{{ end }}{{ if .Modules.fzf }}
+# fzf: Ctrl+R historial, Ctrl+T archivos, Alt+C directorios
+set -gx FZF_DEFAULT_OPTS "--height=40% --layout=reverse --border --color={{ .FzfColors }}"
+if type -q fzf
+    if fzf --fish >/dev/null 2>&1
+        fzf --fish | source
+    else if functions -q fzf_key_bindings
+        fzf_key_bindings
+    end
+end
+{{ end }}
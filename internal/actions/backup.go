@@ -0,0 +1,193 @@
+// Package: actions
+// Backups con timestamp de archivos de configuración
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+)
+
+// BackupFile copia un archivo de configuración a <dir>/backups/<nombre>_<timestamp><ext>
+// Retorna "" si el archivo no existe
+func BackupFile(configPath string) (string, error) {
+	return backupFileTagged(configPath, "")
+}
+
+// backupTimeFormat timestamp de los backups; los microsegundos evitan choques entre escrituras seguidas
+const backupTimeFormat = "2006-01-02_15-04-05.000000"
+
+// preRestoreTag marca los backups que hace restore antes de sobrescribir; LatestBackup los ignora
+const preRestoreTag = "_pre-restore"
+
+// backupFileTagged copia el archivo con tag tras el timestamp
+// El backup se crea con O_EXCL: si el nombre ya existe se añade un contador en lugar de sobrescribirlo
+func backupFileTagged(configPath, tag string) (string, error) {
+	if _, err := os.Stat(configPath); os.IsNotExist(err) {
+		return "", nil // No hay archivo existente
+	}
+
+	// Crear directorio de backups
+	backupDir := backupDirFor(configPath)
+	if err := os.MkdirAll(backupDir, 0755); err != nil {
+		return "", fmt.Errorf("error creando directorio de backups: %w", err)
+	}
+
+	// Copiar archivo
+	src, err := os.Open(configPath)
+	if err != nil {
+		return "", fmt.Errorf("error abriendo archivo original: %w", err)
+	}
+	defer src.Close()
+
+	// Nombre del backup con timestamp
+	name, ext := backupNameParts(configPath)
+	stem := fmt.Sprintf("%s_%s%s", name, time.Now().Format(backupTimeFormat), tag)
+	backupPath := filepath.Join(backupDir, stem+ext)
+	dst, err := os.OpenFile(backupPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	for n := 1; os.IsExist(err); n++ {
+		backupPath = filepath.Join(backupDir, fmt.Sprintf("%s_%d%s", stem, n, ext))
+		dst, err = os.OpenFile(backupPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	}
+	if err != nil {
+		return "", fmt.Errorf("error creando backup: %w", err)
+	}
+	defer dst.Close()
+
+	if _, err := io.Copy(dst, src); err != nil {
+		return "", fmt.Errorf("error copiando backup: %w", err)
+	}
+
+	return backupPath, nil
+}
+
+// backupNameParts separa nombre y extensión; los dotfiles como .zshrc no tienen extensión
+func backupNameParts(configPath string) (string, string) {
+	base := filepath.Base(configPath)
+	ext := filepath.Ext(base)
+	name := strings.TrimSuffix(base, ext)
+	if name == "" {
+		return base, ""
+	}
+	return name, ext
+}
+
+// backupDirFor retorna el directorio de backups para un archivo
+// Los archivos sueltos en $HOME o ~/.config van al directorio de datos de XEBEC
+func backupDirFor(configPath string) string {
+	dir := filepath.Dir(configPath)
+	if dir == userHome() || dir == xdgConfigHome() {
+		return filepath.Join(GetXebecDataDir(), "backups")
+	}
+	return filepath.Join(dir, "backups")
+}
+
+// GetXebecDataDir retorna el directorio de datos de XEBEC según el SO
+func GetXebecDataDir() string {
+	if runtime.GOOS == "windows" {
+		return filepath.Join(os.Getenv("LOCALAPPDATA"), "xebec")
+	}
+	if xdgData := os.Getenv("XDG_DATA_HOME"); xdgData != "" {
+		return filepath.Join(xdgData, "xebec")
+	}
+	return filepath.Join(userHome(), ".local", "share", "xebec")
+}
+
+// backupPattern retorna el patrón de los backups de un archivo (nombre_*ext)
+func backupPattern(configPath string) string {
+	name, ext := backupNameParts(configPath)
+	return filepath.Join(backupDirFor(configPath), name+"_*"+ext)
+}
+
+// LatestBackup retorna el backup más reciente de un archivo ("" si no hay)
+// Los backups previos a un restore no cuentan: restaurar dos veces no vuelve al estado anterior
+func LatestBackup(configPath string) string {
+	matches, _ := filepath.Glob(backupPattern(configPath))
+	var backups []string
+	for _, m := range matches {
+		if !strings.Contains(filepath.Base(m), preRestoreTag) {
+			backups = append(backups, m)
+		}
+	}
+	if len(backups) == 0 {
+		return ""
+	}
+	// El timestamp del nombre ordena cronológicamente
+	sort.Strings(backups)
+	return backups[len(backups)-1]
+}
+
+// RestoreLatestBackup restaura el backup más reciente de un archivo
+// El contenido actual se respalda antes de sobrescribirlo (salvo si ya coincide con el backup)
+func RestoreLatestBackup(configPath string) (string, error) {
+	latest := LatestBackup(configPath)
+	if latest == "" {
+		return "", fmt.Errorf("no hay backups de %s", configPath)
+	}
+	data, err := os.ReadFile(latest)
+	if err != nil {
+		return "", fmt.Errorf("error leyendo backup: %w", err)
+	}
+	if current, err := os.ReadFile(configPath); err == nil && bytes.Equal(current, data) {
+		return latest, nil
+	}
+	if _, err := backupFileTagged(configPath, preRestoreTag); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(configPath, data, 0644); err != nil {
+		return "", fmt.Errorf("error restaurando %s: %w", configPath, err)
+	}
+	return latest, nil
+}
+
+// BackupConfigured respalda los archivos de todas las herramientas configuradas
+func BackupConfigured() ([]string, error) {
+	var backups []string
+	var errs []error
+	for _, c := range Configurators {
+		_, configured, configPath := c.Status()
+		if !configured {
+			continue
+		}
+		backup, err := BackupFile(configPath)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", c.Name, err))
+			continue
+		}
+		if backup != "" {
+			fmt.Printf("✓ %s: %s\n", c.Name, backup)
+			backups = append(backups, backup)
+		}
+	}
+	return backups, errors.Join(errs...)
+}
+
+// RestoreConfigured restaura el último backup de cada herramienta que tenga uno
+func RestoreConfigured() ([]string, error) {
+	var restored []string
+	var errs []error
+	for _, c := range Configurators {
+		_, _, configPath := c.Status()
+		if LatestBackup(configPath) == "" {
+			continue
+		}
+		backup, err := RestoreLatestBackup(configPath)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", c.Name, err))
+			continue
+		}
+		fmt.Printf("✓ %s: %s ← %s\n", c.Name, configPath, filepath.Base(backup))
+		restored = append(restored, configPath)
+	}
+	return restored, errors.Join(errs...)
+}
@@ -0,0 +1,74 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBackupFileUnique(t *testing.T) {
+	setupPlanHome(t)
+	path := filepath.Join(t.TempDir(), "alacritty.toml")
+
+	// Varias escrituras en el mismo segundo no se pisan los backups
+	seen := map[string]bool{}
+	for _, content := range []string{"uno\n", "dos\n", "tres\n"} {
+		writeTestFile(t, path, content)
+		backup, err := BackupFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seen[backup] {
+			t.Fatalf("backup repetido: %s", backup)
+		}
+		seen[backup] = true
+		if data, _ := os.ReadFile(backup); string(data) != content {
+			t.Errorf("%s = %q, want %q", filepath.Base(backup), data, content)
+		}
+	}
+	if got := LatestBackup(path); !seen[got] {
+		t.Errorf("LatestBackup() = %s", got)
+	} else if data, _ := os.ReadFile(got); string(data) != "tres\n" {
+		t.Errorf("LatestBackup() contiene %q, want el último", data)
+	}
+
+	if backup, err := BackupFile(filepath.Join(t.TempDir(), "nope.toml")); backup != "" || err != nil {
+		t.Errorf("BackupFile de un archivo inexistente = %q, %v", backup, err)
+	}
+}
+
+func TestRestoreLatestBackupTwice(t *testing.T) {
+	setupPlanHome(t)
+	path := filepath.Join(t.TempDir(), ".zshrc")
+	writeTestFile(t, path, "original\n")
+	if _, err := BackupFile(path); err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, path, "modificado\n")
+
+	// Restaurar dos veces deja el backup, no alterna con lo que había antes
+	for i := 0; i < 2; i++ {
+		if _, err := RestoreLatestBackup(path); err != nil {
+			t.Fatal(err)
+		}
+		if data, _ := os.ReadFile(path); string(data) != "original\n" {
+			t.Errorf("restore %d: %q, want %q", i+1, data, "original\n")
+		}
+	}
+
+	// Lo que había antes del primer restore queda respaldado una vez
+	matches, _ := filepath.Glob(backupPattern(path))
+	var preRestore []string
+	for _, m := range matches {
+		if strings.Contains(m, preRestoreTag) {
+			preRestore = append(preRestore, m)
+		}
+	}
+	if len(preRestore) != 1 {
+		t.Fatalf("backups previos al restore = %v, want 1", preRestore)
+	}
+	if data, _ := os.ReadFile(preRestore[0]); string(data) != "modificado\n" {
+		t.Errorf("%s = %q, want %q", filepath.Base(preRestore[0]), data, "modificado\n")
+	}
+}
@@ -0,0 +1,237 @@
+// Package: actions
+// Acciones de configuración de Bash
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+)
+
+// Opciones de configuración de Bash (módulos del fragmento xebec.bash)
+type BashConfigOptions struct {
+	Prompt  bool // Hook de Starship o prompt XEBEC si no está instalado
+	Aliases bool // eza y bat
+	Fzf     bool // Atajos Ctrl+R / Ctrl+T / Alt+C y colores de fzf
+	Colors  bool // LS_COLORS con el tema XEBEC
+}
+
+// GetBashConfigOptions retorna los módulos disponibles para configurar
+func GetBashConfigOptions() []ConfigOption {
+	return shellFragmentOptions()
+}
+
+// shellFragmentOptions módulos comunes de los fragmentos de Bash y Fish
+func shellFragmentOptions() []ConfigOption {
+	return []ConfigOption{
+		{ID: "prompt", Title: "Prompt", Description: "Hook de Starship, o prompt XEBEC si no está instalado", Key: "prompt"},
+		{ID: "aliases", Title: "Aliases", Description: "ls/ll/la/lt con eza y cat con bat", Key: "aliases"},
+		{ID: "fzf", Title: "fzf", Description: "Ctrl+R, Ctrl+T, Alt+C y colores XEBEC", Key: "fzf"},
+		{ID: "colors", Title: "Colores", Description: "LS_COLORS con el tema XEBEC", Key: "colors"},
+	}
+}
+
+// BashOptionsFromIDs construye las opciones a partir de los IDs marcados
+func BashOptionsFromIDs(ids []string) BashConfigOptions {
+	return BashConfigOptions{
+		Prompt:  containsID(ids, "prompt"),
+		Aliases: containsID(ids, "aliases"),
+		Fzf:     containsID(ids, "fzf"),
+		Colors:  containsID(ids, "colors"),
+	}
+}
+
+// IsEmpty indica si no se seleccionó ningún módulo
+func (o BashConfigOptions) IsEmpty() bool {
+	return !o.Prompt && !o.Aliases && !o.Fzf && !o.Colors
+}
+
+// modules retorna el mapa de módulos usado por la plantilla
+func (o BashConfigOptions) modules() map[string]bool {
+	return map[string]bool{
+		"prompt":  o.Prompt,
+		"aliases": o.Aliases,
+		"fzf":     o.Fzf,
+		"colors":  o.Colors,
+	}
+}
+
+// ShellFragmentData datos para renderizar los fragmentos de Bash y Fish
+type ShellFragmentData struct {
+	Modules      map[string]bool
+	FragmentPath string
+	LSColors     string
+	FzfColors    string
+	Palette      map[string]string // Colores de terminal XEBEC sin '#'
+}
+
+// FzfColorsFor construye el esquema --color de fzf con una paleta de terminal
+func FzfColorsFor(p TerminalPalette) string {
+	return strings.Join([]string{
+		"fg:" + p.Foreground, "bg:-1", "hl:" + p.Normal.Blue,
+		"fg+:" + p.Bright.White, "bg+:" + p.Selection, "hl+:" + p.Bright.Blue,
+		"info:" + p.Normal.Cyan, "prompt:" + p.Normal.Blue, "pointer:" + p.Bright.Magenta,
+		"marker:" + p.Normal.Green, "spinner:" + p.Bright.Magenta, "header:" + p.Bright.Black,
+		"border:" + p.Bright.Black,
+	}, ",")
+}
+
+// xebecTerminalPalette colores de XebecPalette() sin '#' (formato de set_color en fish)
+func xebecTerminalPalette() map[string]string {
+	return map[string]string{
+		"foreground": strings.TrimPrefix(XebecPalette().Foreground, "#"),
+		"blue":       strings.TrimPrefix(XebecPalette().Normal.Blue, "#"),
+		"cyan":       strings.TrimPrefix(XebecPalette().Normal.Cyan, "#"),
+		"green":      strings.TrimPrefix(XebecPalette().Normal.Green, "#"),
+		"yellow":     strings.TrimPrefix(XebecPalette().Normal.Yellow, "#"),
+		"red":        strings.TrimPrefix(XebecPalette().Normal.Red, "#"),
+		"magenta":    strings.TrimPrefix(XebecPalette().Bright.Magenta, "#"),
+		"gray":       strings.TrimPrefix(XebecPalette().Bright.Black, "#"),
+	}
+}
+
+// newShellFragmentData construye los datos comunes de los fragmentos
+func newShellFragmentData(modules map[string]bool, fragmentPath string) ShellFragmentData {
+	return ShellFragmentData{
+		Modules:      modules,
+		FragmentPath: fragmentPath,
+		LSColors:     XebecLSColors(),
+		FzfColors:    FzfColorsFor(XebecPalette()),
+		Palette:      xebecTerminalPalette(),
+	}
+}
+
+// GetBashFragmentPath retorna la ruta del fragmento xebec.bash que carga .bashrc
+func GetBashFragmentPath() string {
+	return filepath.Join(xdgConfigHome(), "bash", "xebec.bash")
+}
+
+// GetBashProfilePath retorna la ruta de .bash_profile
+func GetBashProfilePath() string {
+	return filepath.Join(userHome(), ".bash_profile")
+}
+
+// GetBashSourcePath retorna la ruta de la plantilla base de Bash
+func GetBashSourcePath() string {
+	_, currentFile, _, _ := runtime.Caller(0)
+	projectRoot := filepath.Dir(filepath.Dir(filepath.Dir(currentFile)))
+	return filepath.Join(projectRoot, "bash", "xebec.bash")
+}
+
+// RenderBashConfig genera el contenido de xebec.bash a partir de la plantilla
+func RenderBashConfig(tmpl string, opts BashConfigOptions) (string, error) {
+	return renderTemplate("xebec.bash", tmpl, newShellFragmentData(opts.modules(), GetBashFragmentPath()))
+}
+
+// ConfigureBash genera xebec.bash y lo carga desde .bashrc dentro de un bloque gestionado
+func ConfigureBash(opts BashConfigOptions) (err error) {
+	defer recordConfigured("bash", opts.modules(), &err)
+
+	// Verificar que Bash esté instalado
+	if !IsBashInstalled() {
+		return fmt.Errorf("Bash no está instalado en el sistema")
+	}
+
+	tmpl, err := os.ReadFile(GetBashSourcePath())
+	if err != nil {
+		return fmt.Errorf("error leyendo plantilla xebec.bash: %w", err)
+	}
+	content, err := RenderBashConfig(string(tmpl), opts)
+	if err != nil {
+		return fmt.Errorf("error generando configuración: %w", err)
+	}
+
+	fragmentPath := GetBashFragmentPath()
+	if err := writeFragment(fragmentPath, content); err != nil {
+		return err
+	}
+
+	blocks := []ManagedBlock{{Component: "bash", Body: fmt.Sprintf("[ -r '%s' ] && . '%s'", fragmentPath, fragmentPath)}}
+	if opts.Prompt && IsStarshipInstalled() {
+		blocks = append(blocks, ManagedBlock{Component: "starship", Body: starshipInitLine("bash")})
+	}
+	if err := upsertBlocks(GetBashRCPath(), blocks); err != nil {
+		return err
+	}
+
+	// En macOS Terminal abre shells de login: sin .bash_profile no se leería .bashrc
+	return wireBashProfile(GetBashProfilePath(), GetBashRCPath(), runtime.GOOS == "darwin")
+}
+
+// wireBashProfile carga .bashrc desde .bash_profile: las shells de login (macOS, SSH) no lo leen
+// Si .bash_profile ya lo carga por su cuenta no se añade nada (todo se ejecutaría dos veces).
+// Solo se crea .bash_profile con create; en ese caso también carga ~/.profile, que bash
+// deja de leer en cuanto existe .bash_profile
+func wireBashProfile(profilePath, rcPath string, create bool) error {
+	data, err := os.ReadFile(profilePath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error leyendo %s: %w", profilePath, err)
+	}
+	missing := os.IsNotExist(err)
+	if missing && !create {
+		return nil
+	}
+
+	if sourcesBashRC(string(data)) {
+		result, err := RemoveBlockFromFile(profilePath, "bashrc")
+		if err != nil {
+			return err
+		}
+		if result.Changed {
+			fmt.Printf("✓ Bloque xebec:bashrc eliminado de %s: ya carga .bashrc\n", profilePath)
+		}
+		return nil
+	}
+
+	var blocks []ManagedBlock
+	if missing {
+		profile := filepath.Join(filepath.Dir(profilePath), ".profile")
+		if _, err := os.Stat(profile); err == nil {
+			blocks = append(blocks, ManagedBlock{Component: "profile", Body: fmt.Sprintf("[ -r '%s' ] && . '%s'", profile, profile)})
+		}
+	}
+	body := fmt.Sprintf("[ -r '%s' ] && . '%s'", rcPath, rcPath)
+	blocks = append(blocks, ManagedBlock{Component: "bashrc", Body: body})
+	return upsertBlocks(profilePath, blocks)
+}
+
+// bashRCSource reconoce ". ~/.bashrc", "source $HOME/.bashrc" y variantes con comillas
+var bashRCSource = regexp.MustCompile(`(^|[\s;&{])(\.|source)\s+["']?[^\s;&|]*/\.bashrc\b`)
+
+// sourcesBashRC indica si un .bash_profile carga .bashrc fuera del bloque xebec:bashrc
+func sourcesBashRC(content string) bool {
+	content, _ = ShellSyntax.Remove(content, "bashrc")
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		if bashRCSource.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBashInstalled verifica si Bash está instalado
+func IsBashInstalled() bool {
+	return isAnyBinaryInstalled([]string{"bash"})
+}
+
+// GetBashStatus retorna el estado actual de Bash
+func GetBashStatus() (installed bool, configured bool, configPath string) {
+	configPath = GetBashFragmentPath()
+	installed = IsBashInstalled()
+
+	if _, err := os.Stat(configPath); err == nil {
+		configured = true
+	}
+
+	return
+}
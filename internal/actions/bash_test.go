@@ -0,0 +1,138 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRenderBashConfigPromptFollowsTheme(t *testing.T) {
+	tmpl, err := os.ReadFile("../../bash/xebec.bash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	light, err := ResolveTheme("xebec", AppearanceLight)
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetActiveTheme(light)
+	t.Cleanup(func() { SetActiveTheme(DefaultTheme()) })
+
+	got, err := RenderBashConfig(string(tmpl), BashConfigOptions{Prompt: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, hex := range []string{light.TerminalPalette.Normal.Blue, light.TerminalPalette.Normal.Green} {
+		if !strings.Contains(got, hexToSGR(hex)+"m") {
+			t.Errorf("el PS1 no usa %s (%s) del tema activo:\n%s", hex, hexToSGR(hex), got)
+		}
+	}
+}
+
+func TestSourcesBashRC(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    bool
+	}{
+		{name: "vacío", content: "", want: false},
+		{name: "if -f", content: "if [ -f ~/.bashrc ]; then\n    . ~/.bashrc\nfi\n", want: true},
+		{name: "source con HOME", content: "[[ -f \"$HOME/.bashrc\" ]] && source \"$HOME/.bashrc\"\n", want: true},
+		{name: "comentado", content: "# . ~/.bashrc\nexport EDITOR=nvim\n", want: false},
+		{name: "solo bloque xebec", content: ShellSyntax.Format("bashrc", "[ -r '/home/u/.bashrc' ] && . '/home/u/.bashrc'"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sourcesBashRC(tt.content); got != tt.want {
+				t.Errorf("sourcesBashRC() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWireBashProfile(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_DATA_HOME", filepath.Join(dir, "data"))
+	profile, rc := filepath.Join(dir, ".bash_profile"), filepath.Join(dir, ".bashrc")
+
+	// Sin carga propia de .bashrc se añade el bloque
+	if err := os.WriteFile(profile, []byte("export EDITOR=nvim\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := wireBashProfile(profile, rc, false); err != nil {
+		t.Fatal(err)
+	}
+	if status, _ := CheckBlockInFile(profile, "bashrc"); status != BlockInSync {
+		t.Fatalf("bloque xebec:bashrc = %s, want %s", status, BlockInSync)
+	}
+
+	// Si el usuario pasa a cargarlo por su cuenta, el bloque sobra
+	data, err := os.ReadFile(profile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(profile, append(data, ". ~/.bashrc\n"...), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := wireBashProfile(profile, rc, false); err != nil {
+		t.Fatal(err)
+	}
+	if status, _ := CheckBlockInFile(profile, "bashrc"); status != BlockMissing {
+		t.Errorf("bloque xebec:bashrc = %s, want %s", status, BlockMissing)
+	}
+	if data, _ := os.ReadFile(profile); !strings.Contains(string(data), ". ~/.bashrc") {
+		t.Errorf("se perdió la carga propia de .bashrc:\n%s", data)
+	}
+}
+
+func TestWireBashProfileMissing(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_DATA_HOME", filepath.Join(dir, "data"))
+	profile, rc, dotProfile := filepath.Join(dir, ".bash_profile"), filepath.Join(dir, ".bashrc"), filepath.Join(dir, ".profile")
+
+	// Fuera de macOS no se crea: bash seguiría leyendo .profile
+	if err := wireBashProfile(profile, rc, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(profile); !os.IsNotExist(err) {
+		t.Fatalf("se creó %s sin create", profile)
+	}
+
+	// Al crearlo se sigue cargando el .profile del usuario, antes que .bashrc
+	if err := os.WriteFile(dotProfile, []byte("export PATH=$HOME/bin:$PATH\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := wireBashProfile(profile, rc, true); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(profile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := string(data)
+	profileAt, rcAt := strings.Index(content, ". '"+dotProfile+"'"), strings.Index(content, ". '"+rc+"'")
+	if profileAt < 0 || rcAt < 0 || profileAt > rcAt {
+		t.Errorf(".bash_profile no carga .profile y después .bashrc:\n%s", content)
+	}
+
+	// Volver a aplicar no cambia nada
+	if err := wireBashProfile(profile, rc, true); err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := os.ReadFile(profile); string(again) != content {
+		t.Errorf("segunda pasada cambió .bash_profile:\n%s", again)
+	}
+
+	// Sin .profile solo se carga .bashrc
+	os.Remove(profile)
+	os.Remove(dotProfile)
+	if err := wireBashProfile(profile, rc, true); err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := os.ReadFile(profile); strings.Contains(string(data), ".profile'") || !strings.Contains(string(data), ". '"+rc+"'") {
+		t.Errorf(".bash_profile sin .profile:\n%s", data)
+	}
+}
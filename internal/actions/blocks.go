@@ -0,0 +1,418 @@
+// Package: actions
+// Bloques gestionados por XEBEC dentro de archivos de inicio de shells
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// BlockSyntax define cómo se escriben los marcadores en un tipo de archivo
+type BlockSyntax struct {
+	Comment string // Prefijo de comentario de línea
+}
+
+// Sintaxis de comentarios soportadas
+var (
+	ShellSyntax      = BlockSyntax{Comment: "#"}  // sh, zsh, bash, fish, nu, PowerShell, TOML, conf
+	LuaSyntax        = BlockSyntax{Comment: "--"} // wezterm.lua
+	XresourcesSyntax = BlockSyntax{Comment: "!"}  // .Xresources, .Xdefaults
+)
+
+// BlockStatus estado de un bloque gestionado
+type BlockStatus string
+
+const (
+	BlockMissing  BlockStatus = "missing"  // El bloque no existe
+	BlockInSync   BlockStatus = "in_sync"  // El contenido coincide con lo que escribió XEBEC
+	BlockModified BlockStatus = "modified" // El usuario editó dentro del bloque
+)
+
+// BlockInfo describe un bloque encontrado en un archivo
+type BlockInfo struct {
+	Component string
+	Status    BlockStatus
+}
+
+// BlockResult resultado de modificar un bloque en un archivo
+type BlockResult struct {
+	Path       string
+	Component  string
+	Changed    bool   // Se escribió el archivo
+	Drifted    bool   // El bloque había sido editado a mano antes de sobrescribirlo
+	BackupPath string // Backup creado antes de escribir
+}
+
+// ManagedBlock contenido de un bloque gestionado identificado por su componente
+type ManagedBlock struct {
+	Component string
+	Body      string
+}
+
+// SyntaxForPath deduce la sintaxis de comentarios a partir del nombre del archivo
+func SyntaxForPath(path string) BlockSyntax {
+	base := strings.ToLower(filepath.Base(path))
+	switch {
+	case strings.HasSuffix(base, ".lua"):
+		return LuaSyntax
+	case base == ".xresources" || base == ".xdefaults":
+		return XresourcesSyntax
+	}
+	return ShellSyntax
+}
+
+// blockStart, blockEnd y blockChecksum son las líneas de control de un bloque
+func (s BlockSyntax) blockStart(component string) string {
+	return fmt.Sprintf("%s >>> xebec:%s >>>", s.Comment, component)
+}
+
+func (s BlockSyntax) blockEnd(component string) string {
+	return fmt.Sprintf("%s <<< xebec:%s <<<", s.Comment, component)
+}
+
+func (s BlockSyntax) checksumPrefix() string {
+	return s.Comment + " xebec:checksum "
+}
+
+// blockChecksum calcula el checksum corto del contenido de un bloque
+func blockChecksum(body string) string {
+	sum := sha256.Sum256([]byte(strings.TrimRight(body, "\n")))
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+// Format envuelve el contenido entre los marcadores del componente
+func (s BlockSyntax) Format(component, body string) string {
+	return strings.Join(s.blockLines(component, body), "\n") + "\n"
+}
+
+func (s BlockSyntax) blockLines(component, body string) []string {
+	body = strings.TrimRight(strings.ReplaceAll(body, "\r\n", "\n"), "\n")
+	lines := []string{s.blockStart(component), s.checksumPrefix() + blockChecksum(body)}
+	if body != "" {
+		lines = append(lines, strings.Split(body, "\n")...)
+	}
+	return append(lines, s.blockEnd(component))
+}
+
+// blockSpan posición de un bloque (líneas de marcadores incluidas)
+type blockSpan struct {
+	start, end int
+}
+
+// findSpans localiza todos los bloques del componente
+// Un marcador de inicio sin cierre se ignora para no tragarse líneas del usuario
+func (s BlockSyntax) findSpans(lines []string, component string) []blockSpan {
+	var spans []blockSpan
+	start := -1
+	for i, line := range lines {
+		switch strings.TrimSpace(line) {
+		case s.blockStart(component):
+			start = i
+		case s.blockEnd(component):
+			if start >= 0 {
+				spans = append(spans, blockSpan{start: start, end: i})
+				start = -1
+			}
+		}
+	}
+	return spans
+}
+
+// statusOf compara el contenido de un bloque con su checksum
+func (s BlockSyntax) statusOf(lines []string, span blockSpan) BlockStatus {
+	inner := lines[span.start+1 : span.end]
+	if len(inner) == 0 || !strings.HasPrefix(strings.TrimSpace(inner[0]), s.checksumPrefix()) {
+		return BlockModified
+	}
+	checksum := strings.TrimPrefix(strings.TrimSpace(inner[0]), s.checksumPrefix())
+	if checksum != blockChecksum(strings.Join(inner[1:], "\n")) {
+		return BlockModified
+	}
+	return BlockInSync
+}
+
+// Upsert inserta o actualiza el bloque del componente en el contenido
+// Los bloques duplicados se colapsan en la posición del primero
+// Retorna el nuevo contenido y si hubo cambios
+func (s BlockSyntax) Upsert(content, component, body string) (string, bool) {
+	eol, lines := splitContent(content)
+	spans := s.findSpans(lines, component)
+	block := s.blockLines(component, body)
+
+	var out []string
+	if len(spans) == 0 {
+		out = append(out, lines...)
+		if len(out) > 0 && strings.TrimSpace(out[len(out)-1]) != "" {
+			out = append(out, "")
+		}
+		out = append(out, block...)
+	} else {
+		out = append(out, lines[:spans[0].start]...)
+		out = append(out, block...)
+		out = append(out, withoutSpans(lines, spans, spans[0].end+1)...)
+	}
+
+	updated := joinContent(out, eol)
+	return updated, updated != content
+}
+
+// UpsertBefore es como Upsert pero, si el bloque no existe, lo inserta antes de la
+// última línea que empieza por anchor (p. ej. "return config" en Lua)
+func (s BlockSyntax) UpsertBefore(content, component, body, anchor string) (string, bool) {
+	eol, lines := splitContent(content)
+	if anchor == "" || len(s.findSpans(lines, component)) > 0 {
+		return s.Upsert(content, component, body)
+	}
+
+	at := -1
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), anchor) {
+			at = i
+		}
+	}
+	if at < 0 {
+		return s.Upsert(content, component, body)
+	}
+
+	out := append([]string{}, lines[:at]...)
+	out = append(out, s.blockLines(component, body)...)
+	out = append(out, "")
+	out = append(out, lines[at:]...)
+
+	updated := joinContent(out, eol)
+	return updated, updated != content
+}
+
+// Remove elimina todos los bloques del componente
+func (s BlockSyntax) Remove(content, component string) (string, bool) {
+	eol, lines := splitContent(content)
+	spans := s.findSpans(lines, component)
+	if len(spans) == 0 {
+		return content, false
+	}
+
+	out := append([]string{}, lines[:spans[0].start]...)
+	out = append(out, withoutSpans(lines, spans, spans[0].end+1)...)
+
+	// Quitar la línea en blanco que separaba el bloque
+	if spans[0].start > 0 && spans[0].start <= len(out) && strings.TrimSpace(out[spans[0].start-1]) == "" &&
+		(spans[0].start == len(out) || strings.TrimSpace(out[spans[0].start]) == "") {
+		out = append(out[:spans[0].start-1], out[spans[0].start:]...)
+	}
+
+	updated := joinContent(out, eol)
+	return updated, updated != content
+}
+
+// Check retorna el estado del bloque del componente
+func (s BlockSyntax) Check(content, component string) BlockStatus {
+	_, lines := splitContent(content)
+	spans := s.findSpans(lines, component)
+	if len(spans) == 0 {
+		return BlockMissing
+	}
+	for _, span := range spans {
+		if s.statusOf(lines, span) == BlockModified {
+			return BlockModified
+		}
+	}
+	if len(spans) > 1 {
+		return BlockModified
+	}
+	return BlockInSync
+}
+
+// Extract retorna el bloque del componente con sus marcadores, tal y como lo genera Format
+// Si el componente aparece varias veces se devuelven todos los bloques seguidos
+func (s BlockSyntax) Extract(content, component string) (string, bool) {
+	_, lines := splitContent(content)
+	spans := s.findSpans(lines, component)
+	if len(spans) == 0 {
+		return "", false
+	}
+	var out []string
+	for _, span := range spans {
+		out = append(out, lines[span.start:span.end+1]...)
+	}
+	return strings.Join(out, "\n") + "\n", true
+}
+
+// List retorna los bloques gestionados presentes en el contenido
+func (s BlockSyntax) List(content string) []BlockInfo {
+	_, lines := splitContent(content)
+	prefix := s.Comment + " >>> xebec:"
+	var blocks []BlockInfo
+	seen := map[string]bool{}
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, prefix) || !strings.HasSuffix(trimmed, " >>>") {
+			continue
+		}
+		component := strings.TrimSuffix(strings.TrimPrefix(trimmed, prefix), " >>>")
+		if seen[component] {
+			continue
+		}
+		seen[component] = true
+		blocks = append(blocks, BlockInfo{Component: component, Status: s.Check(content, component)})
+	}
+	return blocks
+}
+
+// withoutSpans retorna las líneas desde `from` excluyendo los bloques dados
+func withoutSpans(lines []string, spans []blockSpan, from int) []string {
+	var out []string
+	for i := from; i < len(lines); i++ {
+		inSpan := false
+		for _, span := range spans {
+			if i >= span.start && i <= span.end {
+				inSpan = true
+				break
+			}
+		}
+		if !inSpan {
+			out = append(out, lines[i])
+		}
+	}
+	return out
+}
+
+// splitContent separa en líneas y detecta el fin de línea usado (LF o CRLF)
+func splitContent(content string) (string, []string) {
+	eol := "\n"
+	if strings.Contains(content, "\r\n") {
+		eol = "\r\n"
+	}
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = strings.TrimSuffix(content, "\n")
+	if content == "" {
+		return eol, nil
+	}
+	return eol, strings.Split(content, "\n")
+}
+
+// joinContent une las líneas con el fin de línea original
+func joinContent(lines []string, eol string) string {
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, eol) + eol
+}
+
+// UpsertBlockInFile inserta o actualiza un bloque en un archivo, con backup si cambia
+func UpsertBlockInFile(path, component, body string) (BlockResult, error) {
+	return UpsertBlockInFileBefore(path, component, body, "")
+}
+
+// UpsertBlockInFileBefore inserta el bloque antes de la línea anchor si aún no existe
+func UpsertBlockInFileBefore(path, component, body, anchor string) (BlockResult, error) {
+	result := BlockResult{Path: path, Component: component}
+	syntax := SyntaxForPath(path)
+
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return result, fmt.Errorf("error leyendo %s: %w", path, err)
+	}
+
+	result.Drifted = syntax.Check(string(data), component) == BlockModified
+	updated, changed := syntax.UpsertBefore(string(data), component, body, anchor)
+	if !changed {
+		trackBlock(path, component, body)
+		return result, nil
+	}
+
+	if err := writeWithBackup(path, updated, &result); err != nil {
+		return result, err
+	}
+	trackBlock(path, component, body)
+	return result, nil
+}
+
+// RemoveBlockFromFile elimina el bloque de un componente de un archivo
+func RemoveBlockFromFile(path, component string) (BlockResult, error) {
+	result := BlockResult{Path: path, Component: component}
+	syntax := SyntaxForPath(path)
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return result, nil
+	}
+	if err != nil {
+		return result, fmt.Errorf("error leyendo %s: %w", path, err)
+	}
+
+	result.Drifted = syntax.Check(string(data), component) == BlockModified
+	updated, changed := syntax.Remove(string(data), component)
+	if !changed {
+		return result, nil
+	}
+
+	if err := writeWithBackup(path, updated, &result); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// CheckBlockInFile retorna el estado del bloque de un componente en un archivo
+func CheckBlockInFile(path, component string) (BlockStatus, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return BlockMissing, nil
+	}
+	if err != nil {
+		return BlockMissing, fmt.Errorf("error leyendo %s: %w", path, err)
+	}
+	return SyntaxForPath(path).Check(string(data), component), nil
+}
+
+// ListBlocksInFile retorna los bloques gestionados de un archivo
+func ListBlocksInFile(path string) ([]BlockInfo, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error leyendo %s: %w", path, err)
+	}
+	return SyntaxForPath(path).List(string(data)), nil
+}
+
+// writeWithBackup respalda el archivo y escribe el nuevo contenido
+func writeWithBackup(path, content string, result *BlockResult) error {
+	if err := validateBeforeWrite(path, content); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("error creando directorio %s: %w", filepath.Dir(path), err)
+	}
+	backupPath, err := BackupFile(path)
+	if err != nil {
+		return fmt.Errorf("error en backup: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		return fmt.Errorf("error escribiendo %s: %w", path, err)
+	}
+	result.BackupPath = backupPath
+	result.Changed = true
+	return nil
+}
+
+// ManagedBlockFiles retorna los archivos donde XEBEC puede inyectar bloques
+func ManagedBlockFiles() []string {
+	files := []string{GetNushellEnvPath(), GetBashProfilePath()}
+	for _, s := range GetShells() {
+		files = append(files, s.RCPath)
+	}
+	if runtime.GOOS == "windows" {
+		files = append(files, GetWindowsPowerShellProfilePath())
+	}
+	files = append(files, GetWezTermConfigPath(), GetKittyConfigPath(), GetGhosttyConfigPath(), GetXresourcesPath())
+	return files
+}
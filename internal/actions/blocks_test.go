@@ -0,0 +1,192 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBlockSyntaxUpsert(t *testing.T) {
+	syntaxes := []struct {
+		name   string
+		syntax BlockSyntax
+	}{
+		{name: "shell", syntax: ShellSyntax},
+		{name: "lua", syntax: LuaSyntax},
+		{name: "slashes", syntax: BlockSyntax{Comment: "//"}},
+		{name: "xresources", syntax: XresourcesSyntax},
+	}
+	for _, tt := range syntaxes {
+		t.Run(tt.name, func(t *testing.T) {
+			s := tt.syntax
+			user := s.Comment + " del usuario\nuser_line\n"
+
+			// Insertar en un archivo con contenido del usuario
+			out, changed := s.Upsert(user, "prompt", "init prompt")
+			want := user + "\n" + s.Comment + " >>> xebec:prompt >>>\n" +
+				s.Comment + " xebec:checksum " + blockChecksum("init prompt") + "\n" +
+				"init prompt\n" +
+				s.Comment + " <<< xebec:prompt <<<\n"
+			if !changed || out != want {
+				t.Fatalf("Upsert:\n%s\nwant:\n%s", out, want)
+			}
+			if status := s.Check(out, "prompt"); status != BlockInSync {
+				t.Errorf("Check = %s, want %s", status, BlockInSync)
+			}
+
+			// Repetir no duplica
+			again, changed := s.Upsert(out, "prompt", "init prompt")
+			if changed || again != out {
+				t.Errorf("la segunda pasada cambió el contenido:\n%s", again)
+			}
+
+			// Actualizar sustituye el contenido en su sitio
+			updated, changed := s.Upsert(out+"after\n", "prompt", "init prompt v2")
+			if !changed || strings.Count(updated, "xebec:prompt >>>") != 1 || !strings.Contains(updated, "init prompt v2\n") ||
+				!strings.HasSuffix(updated, s.blockEnd("prompt")+"\nafter\n") {
+				t.Errorf("actualización:\n%s", updated)
+			}
+
+			// Quitar deja el archivo como estaba
+			removed, changed := s.Remove(out, "prompt")
+			if !changed || removed != user {
+				t.Errorf("Remove:\n%q\nwant:\n%q", removed, user)
+			}
+			if _, changed := s.Remove(user, "prompt"); changed {
+				t.Error("Remove cambió un archivo sin bloque")
+			}
+		})
+	}
+}
+
+func TestBlockSyntaxDrift(t *testing.T) {
+	s := ShellSyntax
+	content, _ := s.Upsert("", "aliases", "alias ll='ls -l'")
+	edited := strings.Replace(content, "alias ll='ls -l'", "alias ll='ls -la'", 1)
+
+	if status := s.Check(edited, "aliases"); status != BlockModified {
+		t.Errorf("bloque editado: Check = %s, want %s", status, BlockModified)
+	}
+	if blocks := s.List(edited); len(blocks) != 1 || blocks[0].Component != "aliases" || blocks[0].Status != BlockModified {
+		t.Errorf("List = %+v", blocks)
+	}
+	if status := s.Check("alias ll='ls -l'\n", "aliases"); status != BlockMissing {
+		t.Errorf("sin bloque: Check = %s, want %s", status, BlockMissing)
+	}
+
+	// Un bloque duplicado cuenta como modificado y Upsert lo colapsa en uno
+	duplicated := content + "middle\n" + content
+	if status := s.Check(duplicated, "aliases"); status != BlockModified {
+		t.Errorf("duplicado: Check = %s, want %s", status, BlockModified)
+	}
+	collapsed, _ := s.Upsert(duplicated, "aliases", "alias ll='ls -l'")
+	if strings.Count(collapsed, "xebec:aliases >>>") != 1 || !strings.Contains(collapsed, "middle\n") {
+		t.Errorf("Upsert no colapsó el duplicado:\n%s", collapsed)
+	}
+
+	// Un marcador de inicio sin cierre no se traga las líneas del usuario
+	unclosed := s.blockStart("aliases") + "\nuser_line\n"
+	out, _ := s.Upsert(unclosed, "aliases", "alias ll='ls -l'")
+	if !strings.HasPrefix(out, unclosed) {
+		t.Errorf("se perdieron líneas tras un marcador sin cerrar:\n%s", out)
+	}
+}
+
+func TestBlockSyntaxCRLF(t *testing.T) {
+	s := ShellSyntax
+	user := "# perfil\r\nSet-Location C:\\src\r\n"
+	out, changed := s.Upsert(user, "zoxide", "line one\nline two")
+	if !changed {
+		t.Fatal("Upsert no cambió nada")
+	}
+	if strings.Count(out, "\n") != strings.Count(out, "\r\n") {
+		t.Errorf("finales de línea mezclados:\n%q", out)
+	}
+	if status := s.Check(out, "zoxide"); status != BlockInSync {
+		t.Errorf("Check = %s, want %s", status, BlockInSync)
+	}
+	if _, changed := s.Upsert(out, "zoxide", "line one\nline two"); changed {
+		t.Error("la segunda pasada cambió un archivo CRLF")
+	}
+	if removed, _ := s.Remove(out, "zoxide"); removed != user {
+		t.Errorf("Remove:\n%q\nwant:\n%q", removed, user)
+	}
+}
+
+func TestBlockSyntaxUpsertBefore(t *testing.T) {
+	content := "local config = {}\nreturn config\n"
+	out, _ := LuaSyntax.UpsertBefore(content, "xebec", "config.x = 1", "return config")
+	if !strings.HasSuffix(out, LuaSyntax.blockEnd("xebec")+"\n\nreturn config\n") {
+		t.Errorf("el bloque no quedó antes de return:\n%s", out)
+	}
+	// Una vez insertado no se mueve ni se duplica
+	again, changed := LuaSyntax.UpsertBefore(out, "xebec", "config.x = 1", "return config")
+	if changed || again != out {
+		t.Errorf("la segunda pasada cambió el contenido:\n%s", again)
+	}
+}
+
+func TestUpsertBlockInFile(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_DATA_HOME", filepath.Join(dir, "data"))
+	path := filepath.Join(dir, "nuevo", ".zshrc")
+
+	// Archivo nuevo: se crea con el bloque y sin backup
+	result, err := UpsertBlockInFile(path, "zsh", "source ~/.config/zsh/xebec.zsh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Changed || result.BackupPath != "" {
+		t.Errorf("archivo nuevo: %+v", result)
+	}
+	if status, _ := CheckBlockInFile(path, "zsh"); status != BlockInSync {
+		t.Errorf("CheckBlockInFile = %s, want %s", status, BlockInSync)
+	}
+
+	// Sin cambios no se escribe
+	if result, err = UpsertBlockInFile(path, "zsh", "source ~/.config/zsh/xebec.zsh"); err != nil || result.Changed {
+		t.Errorf("segunda pasada: %+v, %v", result, err)
+	}
+
+	// Un bloque editado a mano se reescribe con backup y se informa
+	data, _ := os.ReadFile(path)
+	if err := os.WriteFile(path, []byte(strings.Replace(string(data), "xebec.zsh", "mio.zsh", 1)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if status, _ := CheckBlockInFile(path, "zsh"); status != BlockModified {
+		t.Errorf("tras editar: %s, want %s", status, BlockModified)
+	}
+	result, err = UpsertBlockInFile(path, "zsh", "source ~/.config/zsh/xebec.zsh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Drifted || result.BackupPath == "" {
+		t.Errorf("bloque editado: %+v", result)
+	}
+
+	if blocks, err := ListBlocksInFile(path); err != nil || len(blocks) != 1 {
+		t.Errorf("ListBlocksInFile = %+v, %v", blocks, err)
+	}
+	if result, err = RemoveBlockFromFile(path, "zsh"); err != nil || !result.Changed {
+		t.Errorf("RemoveBlockFromFile: %+v, %v", result, err)
+	}
+	if status, _ := CheckBlockInFile(path, "zsh"); status != BlockMissing {
+		t.Errorf("tras quitar: %s, want %s", status, BlockMissing)
+	}
+}
+
+func TestSyntaxForPath(t *testing.T) {
+	tests := map[string]BlockSyntax{
+		"/home/u/.zshrc":                   ShellSyntax,
+		"/home/u/.wezterm.lua":             LuaSyntax,
+		"/home/u/.Xresources":              XresourcesSyntax,
+		"/home/u/.config/fish/config.fish": ShellSyntax,
+	}
+	for path, want := range tests {
+		if got := SyntaxForPath(path); got != want {
+			t.Errorf("SyntaxForPath(%q) = %+v, want %+v", path, got, want)
+		}
+	}
+}
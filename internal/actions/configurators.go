@@ -0,0 +1,140 @@
+// Package: actions
+// Registro de configuradores: herramientas que xebec sabe configurar
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Configurator herramienta configurable por secciones (terminal, shell o prompt)
+type Configurator struct {
+	ID      string // ID en la CLI y en el estado (alacritty, nushell...)
+	MenuID  string // ID de la opción del menú (terminal_alacritty, shell_nushell...)
+	Name    string
+	Options func() []ConfigOption
+	Status  func() (installed bool, configured bool, configPath string)
+	Apply   func(ids []string) error
+	Source  func() string // Plantilla o directorio de plantillas; nil si se genera en Go
+}
+
+// Configurators herramientas configurables; también se regeneran al cambiar de tema
+var Configurators = []Configurator{
+	{ID: "alacritty", MenuID: "terminal_alacritty", Name: "Alacritty", Options: GetAlacrittyConfigOptions, Status: GetAlacrittyStatus, Source: GetSourceConfigPath,
+		Apply: func(ids []string) error { return ConfigureAlacritty(AlacrittyOptionsFromIDs(ids)) }},
+	{ID: "kitty", MenuID: "terminal_kitty", Name: "Kitty", Options: GetKittyConfigOptions, Status: GetKittyStatus, Source: GetKittySourceDir,
+		Apply: func(ids []string) error { return ConfigureKitty(KittyOptionsFromIDs(ids)) }},
+	{ID: "wezterm", MenuID: "terminal_wezterm", Name: "WezTerm", Options: GetWezTermConfigOptions, Status: GetWezTermStatus, Source: GetWezTermSourceDir,
+		Apply: func(ids []string) error { return ConfigureWezTerm(WezTermOptionsFromIDs(ids)) }},
+	{ID: "ghostty", MenuID: "terminal_ghostty", Name: "Ghostty", Options: GetGhosttyConfigOptions, Status: GetGhosttyStatus, Source: GetGhosttySourceDir,
+		Apply: func(ids []string) error { return ConfigureGhostty(GhosttyOptionsFromIDs(ids)) }},
+	{ID: "windows_terminal", MenuID: "terminal_windows", Name: "Windows Terminal", Options: GetWindowsTerminalConfigOptions, Status: GetWindowsTerminalStatus,
+		Apply: func(ids []string) error { return ConfigureWindowsTerminal(WindowsTerminalOptionsFromIDs(ids)) }},
+	{ID: "gnome_terminal", MenuID: "terminal_gnome", Name: "GNOME Terminal", Options: GetTerminalProfileOptions, Status: GetGnomeTerminalStatus, Source: GetGnomeTerminalSourceDir,
+		Apply: func(ids []string) error { return ConfigureGnomeTerminal(TerminalProfileOptionsFromIDs(ids)) }},
+	{ID: "konsole", MenuID: "terminal_konsole", Name: "Konsole", Options: GetTerminalProfileOptions, Status: GetKonsoleStatus, Source: GetKonsoleSourceDir,
+		Apply: func(ids []string) error { return ConfigureKonsole(TerminalProfileOptionsFromIDs(ids)) }},
+	{ID: "xfce_terminal", MenuID: "terminal_xfce", Name: "XFCE Terminal", Options: GetTerminalProfileOptions, Status: GetXfceTerminalStatus, Source: GetXfceTerminalSourceDir,
+		Apply: func(ids []string) error { return ConfigureXfceTerminal(TerminalProfileOptionsFromIDs(ids)) }},
+	{ID: "foot", MenuID: "terminal_foot", Name: "foot", Options: GetTerminalProfileOptions, Status: GetFootStatus, Source: GetFootSourceDir,
+		Apply: func(ids []string) error { return ConfigureFoot(TerminalProfileOptionsFromIDs(ids)) }},
+	{ID: "rio", MenuID: "terminal_rio", Name: "Rio", Options: GetTerminalProfileOptions, Status: GetRioStatus, Source: GetRioSourceDir,
+		Apply: func(ids []string) error { return ConfigureRio(TerminalProfileOptionsFromIDs(ids)) }},
+	{ID: "tilix", MenuID: "terminal_tilix", Name: "Tilix", Options: GetTerminalProfileOptions, Status: GetTilixStatus, Source: GetTilixSourceDir,
+		Apply: func(ids []string) error { return ConfigureTilix(TerminalProfileOptionsFromIDs(ids)) }},
+	{ID: "xresources", MenuID: "terminal_xterm", Name: "X resources", Options: GetXresourcesConfigOptions, Status: GetXresourcesStatus, Source: GetXresourcesSourceDir,
+		Apply: func(ids []string) error { return ConfigureXresources(TerminalProfileOptionsFromIDs(ids)) }},
+	{ID: "nushell", MenuID: "shell_nushell", Name: "Nushell", Options: GetNushellConfigOptions, Status: GetNushellStatus, Source: GetNushellSourceDir,
+		Apply: func(ids []string) error { return ConfigureNushell(NushellOptionsFromIDs(ids)) }},
+	{ID: "zsh", MenuID: "shell_zsh", Name: "Zsh", Options: GetZshConfigOptions, Status: GetZshStatus, Source: GetZshSourcePath,
+		Apply: func(ids []string) error { return ConfigureZsh(ZshOptionsFromIDs(ids)) }},
+	{ID: "bash", MenuID: "shell_bash", Name: "Bash", Options: GetBashConfigOptions, Status: GetBashStatus, Source: GetBashSourcePath,
+		Apply: func(ids []string) error { return ConfigureBash(BashOptionsFromIDs(ids)) }},
+	{ID: "fish", MenuID: "shell_fish", Name: "Fish", Options: GetFishConfigOptions, Status: GetFishStatus, Source: GetFishSourcePath,
+		Apply: func(ids []string) error { return ConfigureFish(FishOptionsFromIDs(ids)) }},
+	{ID: "powershell", MenuID: "shell_powershell", Name: "PowerShell", Options: GetPowerShellConfigOptions, Status: GetPowerShellStatus,
+		Apply: func(ids []string) error { return ConfigurePowerShell(PowerShellOptionsFromIDs(ids)) }},
+	{ID: "starship", MenuID: "shell_starship", Name: "Starship", Options: GetStarshipConfigOptions, Status: GetStarshipStatus, Source: GetStarshipSourcePath,
+		Apply: func(ids []string) error { return ConfigureStarship(StarshipOptionsFromIDs(ids)) }},
+}
+
+// FindConfigurator busca un configurador por ID (alacritty) o por ID de menú (terminal_alacritty)
+func FindConfigurator(id string) (Configurator, bool) {
+	id = strings.ToLower(strings.TrimSpace(id))
+	for _, c := range Configurators {
+		if c.ID == id || c.MenuID == id || strings.ReplaceAll(c.ID, "_", "-") == id {
+			return c, true
+		}
+	}
+	return Configurator{}, false
+}
+
+// GetConfiguratorIDs retorna los IDs de los configuradores
+func GetConfiguratorIDs() []string {
+	ids := make([]string, 0, len(Configurators))
+	for _, c := range Configurators {
+		ids = append(ids, c.ID)
+	}
+	return ids
+}
+
+// DefaultSections retorna todas las secciones; en los grupos excluyentes solo la primera
+func (c Configurator) DefaultSections() []string {
+	var ids []string
+	groups := map[string]bool{}
+	for _, opt := range c.Options() {
+		if opt.Group != "" {
+			if groups[opt.Group] {
+				continue
+			}
+			groups[opt.Group] = true
+		}
+		ids = append(ids, opt.ID)
+	}
+	return ids
+}
+
+// ResolveSections valida las secciones pedidas; vacío o "all" usa DefaultSections
+// Los grupos excluyentes sin ninguna sección pedida toman la primera, como hace su OptionsFromIDs
+func (c Configurator) ResolveSections(requested []string) ([]string, error) {
+	if len(requested) == 0 || (len(requested) == 1 && requested[0] == "all") {
+		return c.DefaultSections(), nil
+	}
+
+	options := c.Options()
+	known := make([]string, 0, len(options))
+	groupOf := map[string]string{}
+	for _, opt := range options {
+		known = append(known, opt.ID)
+		groupOf[opt.ID] = opt.Group
+	}
+
+	var ids []string
+	groups := map[string]string{}
+	for _, id := range requested {
+		id = strings.TrimSpace(id)
+		if id == "" || containsID(ids, id) {
+			continue
+		}
+		if !containsID(known, id) {
+			return nil, fmt.Errorf("sección desconocida para %s: %s (disponibles: %s)", c.Name, id, strings.Join(known, ", "))
+		}
+		if group := groupOf[id]; group != "" {
+			if other, ok := groups[group]; ok {
+				return nil, fmt.Errorf("%s y %s son excluyentes en %s", other, id, c.Name)
+			}
+			groups[group] = id
+		}
+		ids = append(ids, id)
+	}
+	for _, opt := range options {
+		if opt.Group != "" && groups[opt.Group] == "" {
+			groups[opt.Group] = opt.ID
+			ids = append(ids, opt.ID)
+		}
+	}
+	return ids, nil
+}
@@ -0,0 +1,241 @@
+// Package: actions
+// Cambio del shell por defecto (login shell y terminales)
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// EtcShellsPath lista de shells de login válidos en Unix
+const EtcShellsPath = "/etc/shells"
+
+// shellAliases nombres alternativos aceptados por `xebec shell set-default`
+var shellAliases = map[string]string{
+	"nushell": "nu",
+	"pwsh":    "powershell",
+}
+
+// FindShell busca un shell soportado por ID, nombre o alias
+func FindShell(name string) (Shell, error) {
+	id := strings.ToLower(name)
+	if alias, ok := shellAliases[id]; ok {
+		id = alias
+	}
+	var ids []string
+	for _, s := range GetShells() {
+		if s.ID == id || strings.ToLower(s.Name) == id {
+			return s, nil
+		}
+		ids = append(ids, s.ID)
+	}
+	return Shell{}, fmt.Errorf("shell desconocido: %s (disponibles: %s)", name, strings.Join(ids, ", "))
+}
+
+// ResolveShellProgram retorna la ruta absoluta del ejecutable del shell
+func ResolveShellProgram(shell Shell) (string, error) {
+	for _, b := range shell.Binaries {
+		if p, err := exec.LookPath(b); err == nil {
+			if abs, err := filepath.Abs(p); err == nil {
+				return abs, nil
+			}
+			return p, nil
+		}
+	}
+	// Instalador MSI de Nushell en Windows
+	if shell.ID == "nu" && runtime.GOOS == "windows" {
+		p := filepath.Join(os.Getenv("LOCALAPPDATA"), "Programs", "nu", "bin", "nu.exe")
+		if _, err := os.Stat(p); err == nil {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("%s no está instalado", shell.Name)
+}
+
+// IsShellRegistered verifica si el programa figura en /etc/shells
+func IsShellRegistered(program string) (bool, error) {
+	f, err := os.Open(EtcShellsPath)
+	if err != nil {
+		return false, fmt.Errorf("error leyendo %s: %w", EtcShellsPath, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if strings.TrimSpace(scanner.Text()) == program {
+			return true, nil
+		}
+	}
+	return false, scanner.Err()
+}
+
+// RegisterShell añade el programa a /etc/shells (pide contraseña con sudo)
+func RegisterShell(program string) error {
+	args := sudoArgs("tee", "-a", EtcShellsPath)
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = strings.NewReader(program + "\n")
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("error registrando %s en %s: %w", program, EtcShellsPath, err)
+	}
+	return nil
+}
+
+// ChangeLoginShell ejecuta chsh para el usuario actual
+func ChangeLoginShell(program string) error {
+	cmd := exec.Command("chsh", "-s", program)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("error ejecutando chsh: %w", err)
+	}
+	return nil
+}
+
+// SetDefaultShell fija el shell por defecto del usuario
+// En Unix cambia el login shell con chsh; en Windows no hay login shell, así que
+// actualiza Windows Terminal, Alacritty y WezTerm para que todos abran el mismo shell.
+// confirm se usa para preguntar antes de registrar el shell en /etc/shells.
+func SetDefaultShell(name string, confirm func(question string) bool) error {
+	defer flushHistory("")
+
+	shell, err := FindShell(name)
+	if err != nil {
+		return err
+	}
+	program, err := ResolveShellProgram(shell)
+	if err != nil {
+		return err
+	}
+
+	if runtime.GOOS == "windows" {
+		return SetTerminalsShell(shell, program, true)
+	}
+
+	registered, err := IsShellRegistered(program)
+	if err != nil {
+		return err
+	}
+	if !registered {
+		if !confirm(fmt.Sprintf("%s no está en %s. ¿Registrarlo con sudo?", program, EtcShellsPath)) {
+			return fmt.Errorf("chsh solo acepta shells de %s", EtcShellsPath)
+		}
+		if err := RegisterShell(program); err != nil {
+			return err
+		}
+		fmt.Printf("✓ %s registrado en %s\n", program, EtcShellsPath)
+	}
+
+	if os.Getenv("SHELL") == program {
+		fmt.Printf("• %s ya es el shell de login\n", shell.Name)
+	} else {
+		if err := ChangeLoginShell(program); err != nil {
+			return err
+		}
+		fmt.Printf("✓ Shell de login: %s (efectivo en la próxima sesión)\n", program)
+	}
+
+	// Los terminales que fijan su propio shell también deben coincidir
+	return SetTerminalsShell(shell, program, false)
+}
+
+// SetTerminalsShell actualiza el shell de Windows Terminal, Alacritty y WezTerm
+// Con force=false solo se tocan las configuraciones que ya fijaban un shell propio
+func SetTerminalsShell(shell Shell, program string, force bool) error {
+	if runtime.GOOS == "windows" {
+		if err := setWindowsTerminalShell(shell, program); err != nil {
+			return err
+		}
+	}
+	if err := setAlacrittyShellInFile(program, force); err != nil {
+		return err
+	}
+	return setWezTermShellInFile(program, force)
+}
+
+// setWindowsTerminalShell cambia el perfil por defecto de Windows Terminal
+func setWindowsTerminalShell(shell Shell, program string) error {
+	path := GetWindowsTerminalSettingsPath()
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error leyendo %s: %w", path, err)
+	}
+
+	updated, err := SetWindowsTerminalDefaultProfile(string(data), shell.ID, shell.Name, program)
+	if err != nil {
+		return fmt.Errorf("Windows Terminal: %w", err)
+	}
+	return writeIfChanged(path, string(data), updated, "Windows Terminal")
+}
+
+// setAlacrittyShellInFile fija [terminal.shell] (o [shell] antes de 0.14) en alacritty.toml
+func setAlacrittyShellInFile(program string, force bool) error {
+	path := GetAlacrittyConfigPath()
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error leyendo %s: %w", path, err)
+	}
+
+	content := string(data)
+	if !force && !strings.Contains(content, "[terminal.shell]") && !strings.Contains(content, "[shell]") && !strings.Contains(content, "shell = {") {
+		return nil
+	}
+	return writeIfChanged(path, content, SetAlacrittyShell(content, program, alacrittyShellTable()), "Alacritty")
+}
+
+// setWezTermShellInFile fija config.default_prog en la configuración de WezTerm
+func setWezTermShellInFile(program string, force bool) error {
+	path := GetWezTermConfigPath()
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error leyendo %s: %w", path, err)
+	}
+	// default_prog puede estar en wezterm.lua o en el módulo xebec.lua que genera XEBEC
+	if !force && !strings.Contains(string(data), "default_prog") && !wezTermModuleSetsProg() {
+		return nil
+	}
+
+	if err := SetWezTermDefaultProg(path, program); err != nil {
+		fmt.Printf("⚠ WezTerm: %v\n", err)
+	}
+	return nil
+}
+
+// wezTermModuleSetsProg indica si xebec.lua fija config.default_prog
+func wezTermModuleSetsProg() bool {
+	data, err := os.ReadFile(GetWezTermModulePath())
+	return err == nil && strings.Contains(string(data), "default_prog")
+}
+
+// writeIfChanged respalda y escribe el archivo solo si el contenido cambió
+func writeIfChanged(path, original, updated, label string) error {
+	if original == updated {
+		fmt.Printf("• %s ya usa ese shell\n", label)
+		return nil
+	}
+	var result BlockResult
+	if err := writeWithBackup(path, updated, &result); err != nil {
+		return err
+	}
+	trackWrite(path, updated)
+	fmt.Printf("✓ %s: %s\n", label, path)
+	return nil
+}
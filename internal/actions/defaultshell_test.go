@@ -0,0 +1,116 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFindShell(t *testing.T) {
+	for name, want := range map[string]string{
+		"nu": "nu", "nushell": "nu", "Nushell": "nu",
+		"pwsh": "powershell", "PowerShell": "powershell", "zsh": "zsh",
+	} {
+		shell, err := FindShell(name)
+		if err != nil {
+			t.Errorf("FindShell(%q): %v", name, err)
+			continue
+		}
+		if shell.ID != want {
+			t.Errorf("FindShell(%q) = %s, want %s", name, shell.ID, want)
+		}
+	}
+
+	if _, err := FindShell("tcsh"); err == nil || !strings.Contains(err.Error(), "disponibles") {
+		t.Errorf("FindShell(tcsh) err = %v, want shell desconocido con la lista", err)
+	}
+}
+
+func TestResolveShellProgram(t *testing.T) {
+	bin := t.TempDir()
+	t.Setenv("PATH", bin)
+	shell := Shell{ID: "powershell", Name: "PowerShell", Binaries: []string{"pwsh", "powershell"}}
+
+	if _, err := ResolveShellProgram(shell); err == nil {
+		t.Fatal("ResolveShellProgram sin binarios debería fallar")
+	}
+
+	// El segundo binario también cuenta
+	program := filepath.Join(bin, "powershell")
+	if err := os.WriteFile(program, []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ResolveShellProgram(shell)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != program {
+		t.Errorf("ResolveShellProgram() = %s, want %s", got, program)
+	}
+}
+
+func TestSetTerminalsShell(t *testing.T) {
+	setupPlanHome(t)
+	t.Setenv("WEZTERM_CONFIG_FILE", "")
+	toolVersions["alacritty"] = "0.14.0"
+	t.Cleanup(func() { delete(toolVersions, "alacritty") })
+
+	alacritty := GetAlacrittyConfigPath()
+	wezterm := filepath.Join(os.Getenv("XDG_CONFIG_HOME"), "wezterm", "wezterm.lua")
+	write := func(path, content string) {
+		t.Helper()
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	read := func(path string) string {
+		t.Helper()
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	nu := Shell{ID: "nu", Name: "Nushell"}
+
+	// Sin shell propio no se tocan con force=false
+	alacrittyPlain := "[font]\nsize = 13\n"
+	weztermPlain := "local config = {}\nreturn config\n"
+	write(alacritty, alacrittyPlain)
+	write(wezterm, weztermPlain)
+	if err := SetTerminalsShell(nu, "/usr/bin/nu", false); err != nil {
+		t.Fatal(err)
+	}
+	if got := read(alacritty); got != alacrittyPlain {
+		t.Errorf("alacritty.toml modificado sin shell propio:\n%s", got)
+	}
+	if got := read(wezterm); got != weztermPlain {
+		t.Errorf("wezterm.lua modificado sin default_prog:\n%s", got)
+	}
+
+	// Con shell propio se actualizan
+	write(alacritty, "[terminal.shell]\nprogram = 'bash'\n")
+	write(wezterm, "local config = {}\nconfig.default_prog = { 'bash' }\nreturn config\n")
+	if err := SetTerminalsShell(nu, "/usr/bin/nu", false); err != nil {
+		t.Fatal(err)
+	}
+	if got := read(alacritty); got != "[terminal.shell]\nprogram = '/usr/bin/nu'\n" {
+		t.Errorf("alacritty.toml =\n%s", got)
+	}
+	if got := read(wezterm); !strings.Contains(got, "config.default_prog = { '/usr/bin/nu' }") {
+		t.Errorf("wezterm.lua sin default_prog nuevo:\n%s", got)
+	}
+
+	// force=true añade el shell aunque no hubiera uno
+	write(alacritty, alacrittyPlain)
+	if err := SetTerminalsShell(nu, "/usr/bin/nu", true); err != nil {
+		t.Fatal(err)
+	}
+	if got := read(alacritty); !strings.Contains(got, "[terminal.shell]\nprogram = '/usr/bin/nu'") {
+		t.Errorf("alacritty.toml con force =\n%s", got)
+	}
+}
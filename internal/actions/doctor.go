@@ -0,0 +1,428 @@
+// Package: actions
+// xebec doctor: comprobaciones del entorno con la forma de arreglar cada problema
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// Resultado de una comprobación
+const (
+	DoctorPass = "pass"
+	DoctorWarn = "warn"
+	DoctorFail = "fail"
+)
+
+// DoctorCheck resultado de una comprobación del entorno
+type DoctorCheck struct {
+	ID     string       `json:"id"`
+	Name   string       `json:"name"`
+	Status string       `json:"status"`
+	Detail string       `json:"detail"`
+	Remedy string       `json:"remedy,omitempty"` // Cómo arreglarlo a mano
+	fix    func() error // Arreglo automático seguro; nil si no lo hay
+}
+
+// Fixable indica si xebec doctor --fix puede arreglarlo
+func (c DoctorCheck) Fixable() bool {
+	return c.Status != DoctorPass && c.fix != nil
+}
+
+// Fix aplica el arreglo automático
+func (c DoctorCheck) Fix() error {
+	if c.fix == nil {
+		return fmt.Errorf("%s no tiene arreglo automático", c.Name)
+	}
+	return c.fix()
+}
+
+// RunDoctor ejecuta todas las comprobaciones
+func RunDoctor() []DoctorCheck {
+	var checks []DoctorCheck
+	checks = append(checks, checkShellPrograms()...)
+	checks = append(checks, checkFont())
+	checks = append(checks, checkTruecolor())
+	if runtime.GOOS != "windows" {
+		checks = append(checks, checkLocalBin())
+	}
+	checks = append(checks, checkConfigSyntax()...)
+	checks = append(checks, checkStarshipInit()...)
+	return checks
+}
+
+// checkShellPrograms comprueba que existe el shell que abren Alacritty y WezTerm
+func checkShellPrograms() []DoctorCheck {
+	var checks []DoctorCheck
+	if program, ok := alacrittyShellProgram(); ok {
+		checks = append(checks, shellProgramCheck("shell_alacritty", "Shell de Alacritty", GetAlacrittyConfigPath(), program, func(resolved string) error {
+			return setAlacrittyShellInFile(resolved, true)
+		}))
+	}
+	if program, ok := wezTermShellProgram(); ok {
+		checks = append(checks, shellProgramCheck("shell_wezterm", "Shell de WezTerm", GetWezTermConfigPath(), program, func(resolved string) error {
+			return SetWezTermDefaultProg(GetWezTermConfigPath(), resolved)
+		}))
+	}
+	return checks
+}
+
+// shellProgramCheck comprueba un programa de shell; si falta pero el mismo shell está
+// instalado en otra ruta, el arreglo apunta la configuración a esa ruta
+func shellProgramCheck(id, name, path, program string, set func(resolved string) error) DoctorCheck {
+	check := DoctorCheck{ID: id, Name: name}
+	if programExists(program) {
+		check.Status = DoctorPass
+		check.Detail = program
+		return check
+	}
+
+	check.Status = DoctorFail
+	check.Detail = fmt.Sprintf("%s no existe (%s)", program, path)
+	shellName := programName(program)
+	shell, err := FindShell(shellName)
+	if err != nil {
+		check.Remedy = "Elige un shell instalado: xebec shell set-default <shell>"
+		return check
+	}
+	resolved, err := ResolveShellProgram(shell)
+	if err != nil {
+		check.Remedy = fmt.Sprintf("Instala %s o elige otro: xebec shell set-default <shell>", shell.Name)
+		return check
+	}
+	check.Remedy = fmt.Sprintf("%s está en %s: xebec shell set-default %s", shell.Name, resolved, shell.ID)
+	check.fix = func() error {
+		defer flushHistory("doctor")
+		return set(resolved)
+	}
+	return check
+}
+
+// alacrittyShellProgram lee [terminal.shell] (o el antiguo [shell]) de alacritty.toml
+func alacrittyShellProgram() (string, bool) {
+	data, err := os.ReadFile(GetAlacrittyConfigPath())
+	if err != nil {
+		return "", false
+	}
+	config, err := ParseTOML(string(data))
+	if err != nil {
+		return "", false // Lo informa checkConfigSyntax
+	}
+	shell := config["shell"]
+	if terminal, ok := config["terminal"].(map[string]interface{}); ok && terminal["shell"] != nil {
+		shell = terminal["shell"]
+	}
+	switch s := shell.(type) {
+	case string:
+		return s, s != ""
+	case map[string]interface{}:
+		program, _ := s["program"].(string)
+		return program, program != ""
+	}
+	return "", false
+}
+
+// wezTermDefaultProgRe primer elemento de config.default_prog = { '...' }
+var wezTermDefaultProgRe = regexp.MustCompile(`default_prog\s*=\s*\{\s*(?:'((?:[^'\\]|\\.)*)'|"((?:[^"\\]|\\.)*)")`)
+
+// wezTermShellProgram lee config.default_prog de wezterm.lua
+func wezTermShellProgram() (string, bool) {
+	data, err := os.ReadFile(GetWezTermConfigPath())
+	if err != nil {
+		return "", false
+	}
+	m := wezTermDefaultProgRe.FindStringSubmatch(string(data))
+	if m == nil {
+		return "", false
+	}
+	program := m[1] + m[2]
+	program = strings.NewReplacer(`\\`, `\`, `\'`, `'`, `\"`, `"`).Replace(program)
+	return program, program != ""
+}
+
+// programExists indica si el programa existe como ruta o en el PATH
+func programExists(program string) bool {
+	if strings.ContainsAny(program, `/\`) {
+		info, err := os.Stat(program)
+		return err == nil && !info.IsDir()
+	}
+	_, err := exec.LookPath(program)
+	return err == nil
+}
+
+// programName nombre del ejecutable sin ruta ni .exe (también con rutas de Windows en Unix)
+func programName(program string) string {
+	name := program[strings.LastIndexAny(program, `/\`)+1:]
+	return strings.TrimSuffix(strings.ToLower(name), ".exe")
+}
+
+// checkFont comprueba que la fuente de las plantillas está instalada
+func checkFont() DoctorCheck {
+	check := DoctorCheck{ID: "font", Name: "Fuente " + XebecFont.Family}
+	if where, ok := DetectFont(XebecFont.Family); ok {
+		check.Status = DoctorPass
+		check.Detail = where
+		return check
+	}
+
+	// Solo es un error si alguna herramienta se configuró con la sección font
+	check.Status = DoctorWarn
+	check.Detail = "no instalada"
+	if state, err := LoadState(); err == nil {
+		var users []string
+		for _, tool := range sortedKeys(state.Configured) {
+			for _, id := range state.Configured[tool] {
+				if id == "font" {
+					users = append(users, tool)
+				}
+			}
+		}
+		if len(users) > 0 {
+			check.Status = DoctorFail
+			check.Detail = "no instalada y la usan " + strings.Join(users, ", ")
+		}
+	}
+	check.Remedy = "xebec install font"
+	check.fix = func() error {
+		_, err := InstallFont(XebecFont, "")
+		return err
+	}
+	return check
+}
+
+// checkTruecolor comprueba que el terminal actual anuncia color de 24 bits
+func checkTruecolor() DoctorCheck {
+	check := DoctorCheck{ID: "truecolor", Name: "Color de 24 bits"}
+	colorterm := strings.ToLower(os.Getenv("COLORTERM"))
+	term := os.Getenv("TERM")
+	switch {
+	case colorterm == "truecolor" || colorterm == "24bit":
+		check.Detail = "COLORTERM=" + colorterm
+	case os.Getenv("WT_SESSION") != "":
+		check.Detail = "Windows Terminal"
+	case os.Getenv("TERM_PROGRAM") == "iTerm.app" || os.Getenv("TERM_PROGRAM") == "WezTerm":
+		check.Detail = os.Getenv("TERM_PROGRAM")
+	case strings.Contains(term, "direct") || term == "xterm-kitty" || term == "alacritty" || term == "foot" || term == "xterm-ghostty":
+		check.Detail = "TERM=" + term
+	default:
+		check.Status = DoctorWarn
+		check.Detail = fmt.Sprintf("el terminal no anuncia truecolor (TERM=%s, COLORTERM=%s)", term, os.Getenv("COLORTERM"))
+		check.Remedy = "Usa un terminal con truecolor; si ya lo es, exporta COLORTERM=truecolor"
+		return check
+	}
+	check.Status = DoctorPass
+	return check
+}
+
+// localBinDir directorio de binarios del usuario
+func localBinDir() string {
+	return filepath.Join(userHome(), ".local", "bin")
+}
+
+// checkLocalBin comprueba que ~/.local/bin está en el PATH
+func checkLocalBin() DoctorCheck {
+	dir := localBinDir()
+	check := DoctorCheck{ID: "path_local_bin", Name: "~/.local/bin en el PATH"}
+	for _, entry := range filepath.SplitList(os.Getenv("PATH")) {
+		if filepath.Clean(entry) == dir {
+			check.Status = DoctorPass
+			check.Detail = dir
+			return check
+		}
+	}
+	check.Status = DoctorWarn
+	check.Detail = "los programas instalados en " + dir + " no se encuentran"
+
+	// Si los shells ya lo añaden, solo falta abrir un terminal nuevo
+	wired := 0
+	for _, shell := range DetectShells() {
+		path, body := localBinBlock(shell)
+		if body == "" {
+			continue
+		}
+		if status, err := CheckBlockInFile(path, "local_bin"); err != nil || status == BlockMissing {
+			wired = -1
+			break
+		}
+		wired++
+	}
+	if wired > 0 {
+		check.Remedy = "Los shells ya lo añaden (xebec:local_bin): abre un terminal nuevo"
+		return check
+	}
+
+	check.Remedy = "Añade " + dir + " al PATH en el inicio de tu shell"
+	check.fix = func() error {
+		defer flushHistory("doctor")
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("error creando directorio %s: %w", dir, err)
+		}
+		for _, shell := range DetectShells() {
+			path, body := localBinBlock(shell)
+			if body == "" {
+				continue
+			}
+			if _, err := UpsertBlockInFile(path, "local_bin", body); err != nil {
+				return fmt.Errorf("error añadiendo ~/.local/bin en %s: %w", shell.Name, err)
+			}
+			fmt.Printf("✓ ~/.local/bin añadido al PATH de %s (%s)\n", shell.Name, path)
+		}
+		fmt.Println("• Abre un terminal nuevo para usar el PATH actualizado")
+		return nil
+	}
+	return check
+}
+
+// localBinBlock retorna dónde y cómo añade cada shell ~/.local/bin al PATH
+func localBinBlock(shell Shell) (string, string) {
+	switch shell.ID {
+	case "bash", "zsh":
+		return shell.RCPath, `case ":$PATH:" in *":$HOME/.local/bin:"*) ;; *) export PATH="$HOME/.local/bin:$PATH" ;; esac`
+	case "fish":
+		return shell.RCPath, "fish_add_path -g $HOME/.local/bin"
+	case "nu":
+		return GetNushellEnvPath(), `$env.PATH = ($env.PATH | split row (char esep) | prepend ($nu.home-path | path join ".local" "bin") | uniq)`
+	}
+	return "", ""
+}
+
+// checkConfigSyntax valida las configuraciones TOML y JSON: sintaxis y, si hay esquema, opciones
+func checkConfigSyntax() []DoctorCheck {
+	files := []struct {
+		id, name, path string
+	}{
+		{"syntax_alacritty", "alacritty.toml", GetAlacrittyConfigPath()},
+		{"syntax_starship", "starship.toml", GetStarshipConfigPath()},
+		{"syntax_rio", "Rio config.toml", GetRioConfigPath()},
+		{"syntax_kitty", "kitty xebec.conf", GetKittyXebecPath()},
+		{"syntax_ghostty", "Ghostty xebec", GetGhosttyXebecPath()},
+		{"syntax_windows_terminal", "Windows Terminal settings.json", GetWindowsTerminalSettingsPath()},
+	}
+
+	var checks []DoctorCheck
+	for _, f := range files {
+		if f.path == "" {
+			continue
+		}
+		data, err := os.ReadFile(f.path)
+		if err != nil {
+			continue // No existe: nada que validar
+		}
+		check := DoctorCheck{ID: f.id, Name: "Configuración de " + f.name, Status: DoctorPass, Detail: f.path}
+		var problems []string
+		requires := ""
+		for _, issue := range ValidateConfig(f.path, string(data)) {
+			problems = append(problems, issue.String())
+			if issue.Severity == IssueError {
+				check.Status = DoctorFail
+			} else if check.Status == DoctorPass {
+				check.Status = DoctorWarn
+			}
+			if issue.Requires != "" && (requires == "" || versionAtLeast(issue.Requires, requires)) {
+				requires = issue.Requires
+			}
+		}
+		if len(problems) > 0 {
+			check.Detail = fmt.Sprintf("%s: %s", f.path, strings.Join(problems, "; "))
+		}
+		switch {
+		case check.Status == DoctorFail && requires != "":
+			check.Remedy = fmt.Sprintf("Actualiza a %s o posterior, o vuelve a generar la configuración con esta versión", requires)
+		case check.Status == DoctorFail:
+			check.Remedy = "Corrige el error o restaura el último backup: xebec run restore"
+		case check.Status == DoctorWarn:
+			check.Remedy = "Cambia las opciones obsoletas por las indicadas"
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// versionAtLeast compara versiones numéricas con puntos (1.10 > 1.9)
+func versionAtLeast(version, min string) bool {
+	have, want := strings.Split(version, "."), strings.Split(min, ".")
+	for i := 0; i < len(want); i++ {
+		var h int
+		if i < len(have) {
+			h, _ = strconv.Atoi(have[i])
+		}
+		w, _ := strconv.Atoi(want[i])
+		if h != w {
+			return h > w
+		}
+	}
+	return true
+}
+
+// checkStarshipInit comprueba que cada shell instalado inicializa Starship
+// Un init escrito por el usuario cuenta como válido y nunca se corrige solo
+func checkStarshipInit() []DoctorCheck {
+	if !IsStarshipInstalled() {
+		return []DoctorCheck{{ID: "starship", Name: "Starship", Status: DoctorWarn, Detail: "no instalado", Remedy: "Instala Starship: https://starship.rs"}}
+	}
+
+	var checks []DoctorCheck
+	for _, shell := range DetectShells() {
+		checks = append(checks, checkShellStarshipInit(shell))
+	}
+	return checks
+}
+
+// checkShellStarshipInit comprueba el init de Starship de un shell
+func checkShellStarshipInit(shell Shell) DoctorCheck {
+	check := DoctorCheck{ID: "starship_" + shell.ID, Name: "Starship en " + shell.Name, Status: DoctorPass, Detail: shell.RCPath}
+	userPath, err := userStarshipInitPath(shell)
+	if err != nil {
+		check.Status, check.Detail = DoctorFail, err.Error()
+		return check
+	}
+
+	duplicated := false
+	for _, block := range StarshipInitBlocks(shell) {
+		status, err := CheckBlockInFile(block.Path, "starship")
+		switch {
+		case err != nil:
+			check.Status = DoctorFail
+			check.Detail = err.Error()
+		case status == BlockMissing && userPath != "":
+			// Inicializado por el usuario fuera de XEBEC
+		case status == BlockMissing:
+			check.Status = DoctorFail
+			check.Detail = "sin bloque xebec:starship en " + block.Path
+		case userPath != "" && check.Status == DoctorPass:
+			duplicated = true
+			check.Status = DoctorWarn
+			check.Detail = fmt.Sprintf("Starship se inicializa dos veces: bloque xebec:starship en %s e init propio en %s", block.Path, userPath)
+		case status == BlockModified && check.Status == DoctorPass:
+			check.Status = DoctorWarn
+			check.Detail = "el bloque xebec:starship de " + block.Path + " tiene cambios manuales"
+		}
+	}
+	if check.Status == DoctorPass && userPath != "" {
+		check.Detail = "inicializado fuera de XEBEC en " + userPath
+	}
+
+	switch {
+	case check.Status == DoctorFail && userPath == "":
+		check.Remedy = "xebec config starship"
+		check.fix = func() error {
+			defer flushHistory("starship")
+			return WireStarshipInit([]Shell{shell})
+		}
+	case duplicated:
+		check.Remedy = "Quita el init propio o el bloque xebec:starship"
+	case check.Status == DoctorWarn:
+		// No se arregla solo: reescribir el bloque descartaría los cambios del usuario
+		check.Remedy = "Revisa el bloque o vuelve a generarlo con xebec config starship"
+	}
+	return check
+}
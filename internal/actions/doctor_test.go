@@ -0,0 +1,40 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckShellStarshipInit(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		status  string
+		fix     bool
+	}{
+		{name: "sin init", content: "alias ll='ls -l'\n", status: DoctorFail, fix: true},
+		{name: "bloque xebec", content: ShellSyntax.Format("starship", starshipInitLine("bash")), status: DoctorPass},
+		{name: "init propio", content: "eval \"$(starship init bash)\"\n", status: DoctorPass},
+		{
+			name:    "init propio y bloque xebec",
+			content: "eval \"$(starship init bash)\"\n" + ShellSyntax.Format("starship", starshipInitLine("bash")),
+			status:  DoctorWarn,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rc := filepath.Join(t.TempDir(), ".bashrc")
+			if err := os.WriteFile(rc, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+			check := checkShellStarshipInit(Shell{ID: "bash", Name: "Bash", RCPath: rc})
+			if check.Status != tt.status {
+				t.Errorf("estado = %s (%s), want %s", check.Status, check.Detail, tt.status)
+			}
+			if (check.fix != nil) != tt.fix {
+				t.Errorf("fix = %v, want %v", check.fix != nil, tt.fix)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// Package: actions
+// Acciones de configuración de Fish
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// Opciones de configuración de Fish (módulos de conf.d/xebec.fish)
+type FishConfigOptions struct {
+	Prompt  bool // Hook de Starship o prompt XEBEC si no está instalado
+	Aliases bool // eza y bat
+	Fzf     bool // Atajos Ctrl+R / Ctrl+T / Alt+C y colores de fzf
+	Colors  bool // LS_COLORS y fish_color_* con el tema XEBEC
+}
+
+// GetFishConfigOptions retorna los módulos disponibles para configurar
+func GetFishConfigOptions() []ConfigOption {
+	return shellFragmentOptions()
+}
+
+// FishOptionsFromIDs construye las opciones a partir de los IDs marcados
+func FishOptionsFromIDs(ids []string) FishConfigOptions {
+	return FishConfigOptions{
+		Prompt:  containsID(ids, "prompt"),
+		Aliases: containsID(ids, "aliases"),
+		Fzf:     containsID(ids, "fzf"),
+		Colors:  containsID(ids, "colors"),
+	}
+}
+
+// IsEmpty indica si no se seleccionó ningún módulo
+func (o FishConfigOptions) IsEmpty() bool {
+	return !o.Prompt && !o.Aliases && !o.Fzf && !o.Colors
+}
+
+// modules retorna el mapa de módulos usado por la plantilla
+func (o FishConfigOptions) modules() map[string]bool {
+	return map[string]bool{
+		"prompt":  o.Prompt,
+		"aliases": o.Aliases,
+		"fzf":     o.Fzf,
+		"colors":  o.Colors,
+	}
+}
+
+// GetFishConfDPath retorna la ruta del drop-in conf.d/xebec.fish (fish lo carga solo)
+func GetFishConfDPath() string {
+	return filepath.Join(filepath.Dir(GetFishConfigPath()), "conf.d", "xebec.fish")
+}
+
+// GetFishSourcePath retorna la ruta de la plantilla base de Fish
+func GetFishSourcePath() string {
+	_, currentFile, _, _ := runtime.Caller(0)
+	projectRoot := filepath.Dir(filepath.Dir(filepath.Dir(currentFile)))
+	return filepath.Join(projectRoot, "fish", "xebec.fish")
+}
+
+// RenderFishConfig genera el contenido de conf.d/xebec.fish a partir de la plantilla
+func RenderFishConfig(tmpl string, opts FishConfigOptions) (string, error) {
+	return renderTemplate("xebec.fish", tmpl, newShellFragmentData(opts.modules(), GetFishConfDPath()))
+}
+
+// ConfigureFish genera conf.d/xebec.fish y añade el hook de Starship a config.fish
+func ConfigureFish(opts FishConfigOptions) (err error) {
+	defer recordConfigured("fish", opts.modules(), &err)
+
+	// Verificar que Fish esté instalado
+	if !IsFishInstalled() {
+		return fmt.Errorf("Fish no está instalado en el sistema")
+	}
+
+	tmpl, err := os.ReadFile(GetFishSourcePath())
+	if err != nil {
+		return fmt.Errorf("error leyendo plantilla xebec.fish: %w", err)
+	}
+	content, err := RenderFishConfig(string(tmpl), opts)
+	if err != nil {
+		return fmt.Errorf("error generando configuración: %w", err)
+	}
+
+	if err := writeFragment(GetFishConfDPath(), content); err != nil {
+		return err
+	}
+
+	// config.fish se carga después de conf.d: Starship reemplaza el prompt XEBEC
+	if opts.Prompt && IsStarshipInstalled() {
+		block := ManagedBlock{Component: "starship", Body: starshipInitLine("fish")}
+		if err := upsertBlocks(GetFishConfigPath(), []ManagedBlock{block}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsFishInstalled verifica si Fish está instalado
+func IsFishInstalled() bool {
+	return isAnyBinaryInstalled([]string{"fish"})
+}
+
+// GetFishStatus retorna el estado actual de Fish
+func GetFishStatus() (installed bool, configured bool, configPath string) {
+	configPath = GetFishConfDPath()
+	installed = IsFishInstalled()
+
+	if _, err := os.Stat(configPath); err == nil {
+		configured = true
+	}
+
+	return
+}
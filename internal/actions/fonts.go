@@ -0,0 +1,371 @@
+// Package: actions
+// Detección e instalación de la fuente JetBrains Mono
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// FontSource fuente que usan las plantillas de XEBEC y de dónde se descarga
+type FontSource struct {
+	Family  string
+	Version string
+	URL     string // Archivo .zip con los .ttf
+	SHA256  string // Checksum del .zip; se verifica antes de extraer
+}
+
+// XebecFont fuente de las plantillas (alacritty.toml, kitty, wezterm...)
+// XEBEC_FONT_URL permite usar un espejo interno del mismo .zip (mismo checksum)
+var XebecFont = FontSource{
+	Family:  "JetBrains Mono",
+	Version: "2.304",
+	URL:     "https://github.com/JetBrains/JetBrainsMono/releases/download/v2.304/JetBrainsMono-2.304.zip",
+	SHA256:  "6f6376c6ed2960ea8a963cd7387ec9d76e3f629125bc33d1fdcd7eb7012f7bbf",
+}
+
+// windowsFontsKeys claves del registro donde Windows registra las fuentes
+var windowsFontsKeys = []string{
+	`HKCU\Software\Microsoft\Windows NT\CurrentVersion\Fonts`,
+	`HKLM\Software\Microsoft\Windows NT\CurrentVersion\Fonts`,
+}
+
+// GetUserFontsDir retorna el directorio de fuentes del usuario
+func GetUserFontsDir() string {
+	switch runtime.GOOS {
+	case "windows":
+		return filepath.Join(os.Getenv("LOCALAPPDATA"), "Microsoft", "Windows", "Fonts")
+	case "darwin":
+		return filepath.Join(userHome(), "Library", "Fonts")
+	default:
+		return filepath.Join(xdgDataHome(), "fonts")
+	}
+}
+
+// fontDirs directorios donde se buscan archivos de fuentes
+func fontDirs() []string {
+	switch runtime.GOOS {
+	case "windows":
+		return []string{GetUserFontsDir(), filepath.Join(os.Getenv("WINDIR"), "Fonts")}
+	case "darwin":
+		return []string{GetUserFontsDir(), "/Library/Fonts", "/System/Library/Fonts"}
+	default:
+		return []string{GetUserFontsDir(), filepath.Join(userHome(), ".fonts"), "/usr/local/share/fonts", "/usr/share/fonts"}
+	}
+}
+
+// DetectFont busca una familia tipográfica instalada
+// Retorna dónde se encontró: fc-list, el registro de Windows o un archivo
+func DetectFont(family string) (string, bool) {
+	switch runtime.GOOS {
+	case "windows":
+		for _, key := range windowsFontsKeys {
+			out, err := exec.Command("reg", "query", key).Output()
+			if err == nil && registryHasFontFamily(string(out), family) {
+				return key, true
+			}
+		}
+	default:
+		// fc-list ": family" imprime una línea por fuente con sus nombres separados por comas
+		if out, err := exec.Command("fc-list", ":", "family").Output(); err == nil {
+			for _, line := range strings.Split(string(out), "\n") {
+				for _, name := range strings.Split(line, ",") {
+					if strings.EqualFold(strings.TrimSpace(name), family) {
+						return "fc-list", true
+					}
+				}
+			}
+		}
+	}
+
+	// Sin fontconfig (o con la caché sin actualizar): buscar por nombre de archivo
+	needle := strings.ToLower(strings.ReplaceAll(family, " ", ""))
+	for _, dir := range fontDirs() {
+		found := ""
+		filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return nil
+			}
+			if !d.IsDir() && fontFileMatches(d.Name(), needle) {
+				found = p
+				return filepath.SkipAll
+			}
+			return nil
+		})
+		if found != "" {
+			return found, true
+		}
+	}
+	return "", false
+}
+
+// IsXebecFontInstalled verifica si la fuente de las plantillas está instalada
+func IsXebecFontInstalled() bool {
+	_, ok := DetectFont(XebecFont.Family)
+	return ok
+}
+
+// warnMissingFont avisa si la fuente de las plantillas no está instalada
+func warnMissingFont(program string) {
+	if IsXebecFontInstalled() {
+		return
+	}
+	fmt.Printf("⚠ %s no está instalada: %s usará una fuente de reserva\n", XebecFont.Family, program)
+	fmt.Println("  Instálala con: xebec install font")
+}
+
+// fontStyles estilos que Windows añade al nombre de la familia en el registro
+var fontStyles = map[string]bool{
+	"regular": true, "italic": true, "bold": true, "thin": true, "light": true, "medium": true,
+	"extralight": true, "semibold": true, "extrabold": true, "black": true,
+}
+
+// registryHasFontFamily indica si la salida de `reg query` registra la familia
+// Los valores se llaman "<familia> <estilo> (TrueType)"; "JetBrains Mono NL Regular" es otra familia
+func registryHasFontFamily(output, family string) bool {
+	for _, line := range strings.Split(output, "\n") {
+		name, _, ok := strings.Cut(strings.TrimSpace(line), "    REG_")
+		if !ok {
+			continue
+		}
+		name = strings.TrimSuffix(strings.TrimSuffix(name, " (TrueType)"), " (OpenType)")
+		// Las colecciones .ttc registran varias caras separadas por &
+		for _, face := range strings.Split(name, " & ") {
+			if fontFaceIsFamily(strings.TrimSpace(face), family) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// fontFaceIsFamily indica si una cara ("JetBrains Mono Bold Italic") es de la familia
+func fontFaceIsFamily(face, family string) bool {
+	if len(face) < len(family) || !strings.EqualFold(face[:len(family)], family) {
+		return false
+	}
+	for _, word := range strings.Fields(face[len(family):]) {
+		if !fontStyles[strings.ToLower(word)] {
+			return false
+		}
+	}
+	return len(face) == len(family) || face[len(family)] == ' '
+}
+
+// windowsFontValueName nombre del valor del registro para un archivo de la familia
+// JetBrainsMono-ExtraBoldItalic.ttf -> "JetBrains Mono ExtraBold Italic (TrueType)", como lo
+// registra Windows al instalar la fuente y como lo busca DetectFont
+func windowsFontValueName(family, file string) string {
+	base := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+	name := family
+	if _, style, ok := strings.Cut(base, "-"); ok && style != "" {
+		if rest, italic := strings.CutSuffix(style, "Italic"); italic && rest != "" {
+			style = rest + " Italic"
+		}
+		name += " " + style
+	}
+	if strings.EqualFold(filepath.Ext(file), ".otf") {
+		return name + " (OpenType)"
+	}
+	return name + " (TrueType)"
+}
+
+// fontFileMatches indica si un archivo de fuente es de la familia needle (minúsculas, sin espacios)
+// JetBrainsMono-Regular.ttf y JetBrainsMono[wght].ttf lo son; JetBrainsMonoNerdFont-Regular.ttf
+// y JetBrainsMonoNL-Regular.ttf son otras familias
+func fontFileMatches(name, needle string) bool {
+	name = strings.ToLower(name)
+	if !isFontFile(name) || !strings.HasPrefix(name, needle) {
+		return false
+	}
+	next := name[len(needle)]
+	return !(next >= 'a' && next <= 'z' || next >= '0' && next <= '9')
+}
+
+// isFontFile indica si un archivo es una fuente TrueType u OpenType
+func isFontFile(name string) bool {
+	ext := strings.ToLower(filepath.Ext(name))
+	return ext == ".ttf" || ext == ".otf"
+}
+
+// InstallFont instala una fuente en el directorio del usuario y actualiza la caché
+// archive es un .zip local; si está vacío se descarga de XEBEC_FONT_URL o de src.URL
+func InstallFont(src FontSource, archive string) ([]string, error) {
+	if archive == "" {
+		url := src.URL
+		if mirror := os.Getenv("XEBEC_FONT_URL"); mirror != "" {
+			url = mirror
+		}
+		fmt.Printf("→ Descargando %s %s\n", src.Family, url)
+		tmp, err := downloadToTemp(url)
+		if err != nil {
+			return nil, err
+		}
+		defer os.Remove(tmp)
+		archive = tmp
+	}
+	if err := verifySHA256(archive, src.SHA256); err != nil {
+		return nil, err
+	}
+
+	dest := GetUserFontsDir()
+	if runtime.GOOS != "windows" && runtime.GOOS != "darwin" {
+		dest = filepath.Join(dest, strings.ReplaceAll(src.Family, " ", ""))
+	}
+	installed, err := extractFonts(archive, dest)
+	if err != nil {
+		return nil, err
+	}
+	if len(installed) == 0 {
+		return nil, fmt.Errorf("el archivo %s no contiene fuentes .ttf u .otf", archive)
+	}
+
+	if err := refreshFontCache(src.Family, dest, installed); err != nil {
+		return installed, err
+	}
+	return installed, nil
+}
+
+// verifySHA256 comprueba el checksum de un archivo; want vacío no verifica
+func verifySHA256(file, want string) error {
+	if want == "" {
+		return nil
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return fmt.Errorf("error abriendo %s: %w", file, err)
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return fmt.Errorf("error leyendo %s: %w", file, err)
+	}
+	if got := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(got, want) {
+		return fmt.Errorf("checksum SHA-256 de %s no coincide: esperado %s, obtenido %s", file, want, got)
+	}
+	return nil
+}
+
+// downloadToTemp descarga una URL a un archivo temporal
+func downloadToTemp(url string) (string, error) {
+	client := &http.Client{Timeout: 5 * time.Minute}
+	resp, err := client.Get(url)
+	if err != nil {
+		return "", fmt.Errorf("error descargando %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error descargando %s: %s", url, resp.Status)
+	}
+
+	f, err := os.CreateTemp("", "xebec-font-*.zip")
+	if err != nil {
+		return "", fmt.Errorf("error creando archivo temporal: %w", err)
+	}
+	defer f.Close()
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		os.Remove(f.Name())
+		return "", fmt.Errorf("error descargando %s: %w", url, err)
+	}
+	return f.Name(), nil
+}
+
+// extractFonts copia las fuentes de un .zip a dest
+// Si el archivo trae una carpeta ttf/ solo se instalan esas (no las variables ni las webfonts)
+func extractFonts(archive, dest string) ([]string, error) {
+	r, err := zip.OpenReader(archive)
+	if err != nil {
+		return nil, fmt.Errorf("error abriendo %s: %w", archive, err)
+	}
+	defer r.Close()
+
+	hasTTFDir := false
+	for _, f := range r.File {
+		if strings.Contains("/"+f.Name, "/ttf/") && isFontFile(f.Name) {
+			hasTTFDir = true
+			break
+		}
+	}
+
+	if err := os.MkdirAll(dest, 0755); err != nil {
+		return nil, fmt.Errorf("error creando directorio %s: %w", dest, err)
+	}
+
+	var installed []string
+	for _, f := range r.File {
+		if f.FileInfo().IsDir() || !isFontFile(f.Name) {
+			continue
+		}
+		if hasTTFDir && !strings.Contains("/"+f.Name, "/ttf/") {
+			continue
+		}
+		// Solo el nombre del archivo: evita rutas fuera de dest
+		target := filepath.Join(dest, path.Base(f.Name))
+		if err := extractZipFile(f, target); err != nil {
+			return installed, err
+		}
+		installed = append(installed, target)
+	}
+	return installed, nil
+}
+
+// extractZipFile escribe una entrada del .zip en target
+func extractZipFile(f *zip.File, target string) error {
+	rc, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("error leyendo %s: %w", f.Name, err)
+	}
+	defer rc.Close()
+
+	out, err := os.Create(target)
+	if err != nil {
+		return fmt.Errorf("error escribiendo %s: %w", target, err)
+	}
+	defer out.Close()
+	if _, err := io.Copy(out, rc); err != nil {
+		return fmt.Errorf("error escribiendo %s: %w", target, err)
+	}
+	return nil
+}
+
+// refreshFontCache hace visibles las fuentes nuevas
+// Linux: fc-cache; Windows: registro del usuario; macOS las detecta solo
+func refreshFontCache(family, dir string, files []string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		return nil
+	case "windows":
+		for _, file := range files {
+			name := windowsFontValueName(family, file)
+			cmd := exec.Command("reg", "add", windowsFontsKeys[0], "/v", name, "/t", "REG_SZ", "/d", file, "/f")
+			if out, err := cmd.CombinedOutput(); err != nil {
+				return fmt.Errorf("error registrando %s: %w: %s", filepath.Base(file), err, strings.TrimSpace(string(out)))
+			}
+		}
+		return nil
+	default:
+		if _, err := exec.LookPath("fc-cache"); err != nil {
+			fmt.Println("⚠ fc-cache no encontrado: la fuente estará disponible al reiniciar la sesión")
+			return nil
+		}
+		if out, err := exec.Command("fc-cache", "-f", dir).CombinedOutput(); err != nil {
+			return fmt.Errorf("error actualizando la caché de fuentes: %w: %s", err, strings.TrimSpace(string(out)))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,88 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFontFileMatches(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"JetBrainsMono-Regular.ttf", true},
+		{"JetBrainsMono[wght].ttf", true},
+		{"jetbrainsmono-bold.otf", true},
+		{"JetBrainsMonoNerdFont-Regular.ttf", false},
+		{"JetBrainsMonoNL-Regular.ttf", false},
+		{"JetBrainsMono-Regular.woff2", false},
+	}
+	for _, tt := range tests {
+		if got := fontFileMatches(tt.name, "jetbrainsmono"); got != tt.want {
+			t.Errorf("fontFileMatches(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestInstallFontChecksum(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	archive := filepath.Join(t.TempDir(), "font.zip")
+	if err := os.WriteFile(archive, []byte("no es un zip"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// El checksum se verifica antes de abrir el .zip
+	src := FontSource{Family: "Test Mono", SHA256: "0000000000000000000000000000000000000000000000000000000000000000"}
+	if _, err := InstallFont(src, archive); err == nil || !strings.Contains(err.Error(), "SHA-256") {
+		t.Errorf("InstallFont con checksum distinto: err = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(GetUserFontsDir(), "TestMono")); !os.IsNotExist(err) {
+		t.Error("se creó el directorio de la fuente con un checksum inválido")
+	}
+}
+
+func TestWindowsFontRegistry(t *testing.T) {
+	names := map[string]string{
+		"JetBrainsMono-Regular.ttf":         "JetBrains Mono Regular (TrueType)",
+		"JetBrainsMono-ExtraBoldItalic.ttf": "JetBrains Mono ExtraBold Italic (TrueType)",
+		"JetBrainsMono-Italic.ttf":          "JetBrains Mono Italic (TrueType)",
+		"JetBrainsMono[wght].ttf":           "JetBrains Mono (TrueType)",
+		"JetBrainsMono-Bold.otf":            "JetBrains Mono Bold (OpenType)",
+	}
+	for file, want := range names {
+		if got := windowsFontValueName("JetBrains Mono", file); got != want {
+			t.Errorf("windowsFontValueName(%s) = %q, want %q", file, got, want)
+		}
+	}
+
+	query := func(values ...string) string {
+		out := "\r\nHKEY_CURRENT_USER\\Software\\Microsoft\\Windows NT\\CurrentVersion\\Fonts\r\n"
+		for _, v := range values {
+			out += "    " + v + "    REG_SZ    C:\\Fonts\\x.ttf\r\n"
+		}
+		return out
+	}
+	tests := []struct {
+		name, output string
+		want         bool
+	}{
+		{"registrada por xebec", query(windowsFontValueName("JetBrains Mono", "JetBrainsMono-Regular.ttf")), true},
+		{"estilos combinados", query("Arial (TrueType)", "JetBrains Mono ExtraBold Italic (TrueType)"), true},
+		{"sin estilo", query("JetBrains Mono (TrueType)"), true},
+		{"otra variante NL", query("JetBrains Mono NL Regular (TrueType)"), false},
+		{"Nerd Font", query("JetBrainsMono Nerd Font Regular (TrueType)", "JetBrains Mono Nerd Font (TrueType)"), false},
+		{"familia en los datos, no en el nombre", query("Otra (TrueType)") + "JetBrains Mono\r\n", false},
+		{"colección .ttc", query("Cambria & Cambria Math (TrueType)"), false},
+	}
+	for _, tt := range tests {
+		if got := registryHasFontFamily(tt.output, "JetBrains Mono"); got != tt.want {
+			t.Errorf("%s: registryHasFontFamily() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	if !registryHasFontFamily(query("Cambria & Cambria Math (TrueType)"), "cambria math") {
+		t.Error("registryHasFontFamily no encuentra una cara de una colección .ttc")
+	}
+}
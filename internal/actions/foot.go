@@ -0,0 +1,64 @@
+// Package: actions
+// Acciones de configuración de foot (Wayland)
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// GetFootConfigPath retorna la ruta de foot.ini
+func GetFootConfigPath() string {
+	return filepath.Join(xdgConfigHome(), "foot", "foot.ini")
+}
+
+// GetFootSourceDir retorna el directorio con la plantilla de foot
+func GetFootSourceDir() string {
+	_, currentFile, _, _ := runtime.Caller(0)
+	projectRoot := filepath.Dir(filepath.Dir(filepath.Dir(currentFile)))
+	return filepath.Join(projectRoot, "foot")
+}
+
+// RenderFootConfig genera las claves XEBEC de foot.ini
+func RenderFootConfig(tmpl string, data ProfileTemplateData) (string, error) {
+	return renderTemplate("foot.ini", tmpl, data)
+}
+
+// ConfigureFoot fija las claves XEBEC en foot.ini conservando el resto
+func ConfigureFoot(opts TerminalProfileOptions) (err error) {
+	defer recordConfigured("foot", opts.modules(), &err)
+
+	tmpl, err := os.ReadFile(filepath.Join(GetFootSourceDir(), "foot.ini"))
+	if err != nil {
+		return fmt.Errorf("error leyendo plantilla foot.ini: %w", err)
+	}
+
+	data := NewProfileTemplateData(opts)
+	if opts.Shell && data.Command == "" {
+		fmt.Println("⚠ Nushell no está instalado; shell no se modifica")
+	}
+	rendered, err := RenderFootConfig(string(tmpl), data)
+	if err != nil {
+		return fmt.Errorf("error generando configuración: %w", err)
+	}
+	return mergeINIFile(GetFootConfigPath(), rendered)
+}
+
+// IsFootInstalled verifica si foot está instalado
+func IsFootInstalled() bool {
+	return isAnyBinaryInstalled([]string{"foot"})
+}
+
+// GetFootStatus retorna el estado actual de foot
+// configured indica si foot.ini ya tiene el fondo XEBEC
+func GetFootStatus() (installed bool, configured bool, configPath string) {
+	configPath = GetFootConfigPath()
+	installed = IsFootInstalled()
+	configured = iniHasValue(configPath, "colors", "background", bareHex(XebecPalette().Background))
+	return
+}
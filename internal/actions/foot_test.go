@@ -0,0 +1,48 @@
+package actions
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestConfigureFootKeepsUserSections(t *testing.T) {
+	setupPlanHome(t)
+	fakeBinaries(t)
+	path := GetFootConfigPath()
+	user := "[main]\nfont=Iosevka:size=11\nterm=xterm-256color\n\n[key-bindings]\nspawn-terminal=Control+Shift+n\n\n[colors]\nalpha=0.9\n"
+	writeTestFile(t, path, user)
+
+	opts := TerminalProfileOptions{Colors: true, Font: true}
+	if err := ConfigureFoot(opts); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := string(data)
+
+	values := iniValues(content)
+	p := DefaultTheme().TerminalPalette
+	expectValue(t, values, "main.font", "JetBrains Mono:size=13")
+	expectValue(t, values, "main.term", "xterm-256color")
+	expectValue(t, values, "key-bindings.spawn-terminal", "Control+Shift+n")
+	expectValue(t, values, "colors.alpha", "0.9") // Sin el grupo window no se toca
+	expectValue(t, values, "colors.background", strings.TrimPrefix(p.Background, "#"))
+	expectValue(t, values, "colors.regular1", strings.TrimPrefix(p.Normal.Red, "#"))
+	if n := strings.Count(content, "font="); n != 1 {
+		t.Errorf("%d claves font, want 1:\n%s", n, content)
+	}
+	// La clave del usuario se reemplaza en su sitio, sin mover las secciones
+	if !strings.HasPrefix(content, "[main]\nfont=JetBrains Mono:size=13\nterm=xterm-256color\n\n[key-bindings]\n") {
+		t.Errorf("foot.ini reordenado:\n%s", content)
+	}
+
+	if err := ConfigureFoot(opts); err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := os.ReadFile(path); string(again) != content {
+		t.Errorf("segunda pasada cambió foot.ini:\n%s", again)
+	}
+}
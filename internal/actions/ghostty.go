@@ -0,0 +1,176 @@
+// Package: actions
+// Acciones de configuración de Ghostty
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// Opciones de configuración de Ghostty (mismos grupos que Alacritty)
+type GhosttyConfigOptions struct {
+	Window bool // window-decoration, background-opacity, padding
+	Colors bool // foreground, background, palette 0-15
+	Font   bool // font-family, font-size
+	Cursor bool // cursor-style, cursor-style-blink
+	Shell  bool // command
+}
+
+// GetGhosttyConfigOptions retorna las opciones disponibles para configurar
+func GetGhosttyConfigOptions() []ConfigOption {
+	return []ConfigOption{
+		{ID: "window", Title: "Ventana", Description: "Sin decoraciones, opacity, padding", Key: "window"},
+		{ID: "colors", Title: "Colores", Description: "Tema XEBEC - palette 0-15, cursor, selección", Key: "colors"},
+		{ID: "font", Title: "Fuente", Description: "JetBrains Mono, tamaño", Key: "font"},
+		{ID: "cursor", Title: "Cursor", Description: "Barra parpadeante", Key: "cursor"},
+		{ID: "shell", Title: "Shell", Description: "command con Nushell", Key: "shell"},
+	}
+}
+
+// GhosttyOptionsFromIDs construye las opciones a partir de los IDs marcados
+func GhosttyOptionsFromIDs(ids []string) GhosttyConfigOptions {
+	return GhosttyConfigOptions{
+		Window: containsID(ids, "window"),
+		Colors: containsID(ids, "colors"),
+		Font:   containsID(ids, "font"),
+		Cursor: containsID(ids, "cursor"),
+		Shell:  containsID(ids, "shell"),
+	}
+}
+
+// IsEmpty indica si no se seleccionó ninguna opción
+func (o GhosttyConfigOptions) IsEmpty() bool {
+	return !o.Window && !o.Colors && !o.Font && !o.Cursor && !o.Shell
+}
+
+// modules retorna el mapa de módulos usado por la plantilla
+func (o GhosttyConfigOptions) modules() map[string]bool {
+	return map[string]bool{
+		"window": o.Window,
+		"colors": o.Colors,
+		"font":   o.Font,
+		"cursor": o.Cursor,
+		"shell":  o.Shell,
+	}
+}
+
+// GhosttyTemplateData datos para renderizar el archivo xebec de Ghostty
+type GhosttyTemplateData struct {
+	Modules  map[string]bool
+	ConfPath string
+	Palette  TerminalPalette
+	Colors   []string // palette 0-15
+	Command  string   // Línea de comandos del shell; vacía si no está instalado
+}
+
+// GetGhosttyConfigPath retorna el archivo de configuración que Ghostty carga
+// Usa config.ghostty o, en macOS, Application Support si ya existen; si no, ~/.config/ghostty/config
+func GetGhosttyConfigPath() string {
+	dir := filepath.Join(xdgConfigHome(), "ghostty")
+	candidates := []string{filepath.Join(dir, "config.ghostty"), filepath.Join(dir, "config")}
+	if runtime.GOOS == "darwin" {
+		appSupport := filepath.Join(userHome(), "Library", "Application Support", "com.mitchellh.ghostty")
+		candidates = append(candidates, filepath.Join(appSupport, "config.ghostty"), filepath.Join(appSupport, "config"))
+	}
+	for _, p := range candidates {
+		if _, err := os.Stat(p); err == nil {
+			return p
+		}
+	}
+	return filepath.Join(dir, "config")
+}
+
+// GetGhosttyXebecPath retorna la ruta del archivo xebec incluido con config-file
+func GetGhosttyXebecPath() string {
+	return filepath.Join(xdgConfigHome(), "ghostty", "xebec")
+}
+
+// GetGhosttySourceDir retorna el directorio con la plantilla de Ghostty
+func GetGhosttySourceDir() string {
+	_, currentFile, _, _ := runtime.Caller(0)
+	projectRoot := filepath.Dir(filepath.Dir(filepath.Dir(currentFile)))
+	return filepath.Join(projectRoot, "ghostty")
+}
+
+// NewGhosttyTemplateData construye los datos de plantilla para la máquina actual
+func NewGhosttyTemplateData(opts GhosttyConfigOptions) GhosttyTemplateData {
+	data := GhosttyTemplateData{
+		Modules:  opts.modules(),
+		ConfPath: GetGhosttyXebecPath(),
+		Palette:  XebecPalette(),
+		Colors:   append(XebecPalette().Normal.List(), XebecPalette().Bright.List()...),
+	}
+	// Nushell con --login, igual que la plantilla de Alacritty
+	if shell, err := FindShell("nu"); err == nil {
+		if program, err := ResolveShellProgram(shell); err == nil {
+			data.Command = posixQuote(program) + " --login"
+		}
+	}
+	return data
+}
+
+// RenderGhosttyConfig genera el archivo xebec a partir de la plantilla
+func RenderGhosttyConfig(tmpl string, data GhosttyTemplateData) (string, error) {
+	return renderTemplate("xebec", tmpl, data)
+}
+
+// ConfigureGhostty genera el archivo xebec y lo incluye con config-file
+func ConfigureGhostty(opts GhosttyConfigOptions) (err error) {
+	defer recordConfigured("ghostty", opts.modules(), &err)
+
+	// Verificar que Ghostty esté instalado
+	if !IsGhosttyInstalled() {
+		return fmt.Errorf("Ghostty no está instalado en el sistema")
+	}
+
+	tmpl, err := os.ReadFile(filepath.Join(GetGhosttySourceDir(), "xebec"))
+	if err != nil {
+		return fmt.Errorf("error leyendo plantilla xebec: %w", err)
+	}
+
+	data := NewGhosttyTemplateData(opts)
+	if opts.Shell && data.Command == "" {
+		fmt.Println("⚠ Nushell no está instalado; command no se modifica")
+	}
+	content, err := RenderGhosttyConfig(string(tmpl), data)
+	if err != nil {
+		return fmt.Errorf("error generando configuración: %w", err)
+	}
+	if err := writeFragment(data.ConfPath, content); err != nil {
+		return err
+	}
+
+	// Ruta absoluta: la configuración principal puede estar en Application Support
+	body := fmt.Sprintf("config-file = %q", data.ConfPath)
+	return upsertBlocks(GetGhosttyConfigPath(), []ManagedBlock{{Component: "ghostty", Body: body}})
+}
+
+// IsGhosttyInstalled verifica si Ghostty está instalado
+func IsGhosttyInstalled() bool {
+	if isAnyBinaryInstalled([]string{"ghostty"}) {
+		return true
+	}
+	if runtime.GOOS == "darwin" {
+		if _, err := os.Stat("/Applications/Ghostty.app"); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// GetGhosttyStatus retorna el estado actual de Ghostty
+func GetGhosttyStatus() (installed bool, configured bool, configPath string) {
+	configPath = GetGhosttyXebecPath()
+	installed = IsGhosttyInstalled()
+
+	if _, err := os.Stat(configPath); err == nil {
+		configured = true
+	}
+
+	return
+}
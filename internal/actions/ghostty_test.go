@@ -0,0 +1,81 @@
+package actions
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRenderGhosttyConfigGolden(t *testing.T) {
+	goldenHome(t)
+	tmpl, err := os.ReadFile(filepath.Join(GetGhosttySourceDir(), "xebec"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		ids  []string
+	}{
+		{name: "all", ids: Configurator{Options: GetGhosttyConfigOptions}.DefaultSections()},
+		{name: "colors", ids: []string{"colors"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := NewGhosttyTemplateData(GhosttyOptionsFromIDs(tt.ids))
+			// command no puede depender de dónde esté nu en esta máquina
+			data.Command = "/usr/bin/nu --login"
+			out, err := RenderGhosttyConfig(string(tmpl), data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertGolden(t, "ghostty-"+tt.name, out)
+		})
+	}
+}
+
+func TestConfigureGhosttyConfigFile(t *testing.T) {
+	setupPlanHome(t)
+	fakeBinaries(t, "ghostty")
+	opts := GhosttyOptionsFromIDs([]string{"colors"})
+	dir := filepath.Join(os.Getenv("XDG_CONFIG_HOME"), "ghostty")
+	hook := fmt.Sprintf("config-file = %q", GetGhosttyXebecPath())
+
+	configure := func(path string) string {
+		t.Helper()
+		if err := ConfigureGhostty(opts); err != nil {
+			t.Fatal(err)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+
+	// config.ghostty del usuario: la ruta absoluta del archivo xebec se añade una sola vez
+	configPath := filepath.Join(dir, "config.ghostty")
+	user := "font-size = 15\nkeybind = ctrl+t=new_tab\n"
+	writeTestFile(t, configPath, user)
+	first := configure(configPath)
+	if n := strings.Count(first, hook); n != 1 {
+		t.Fatalf("%d líneas %s, want 1:\n%s", n, hook, first)
+	}
+	if !strings.HasPrefix(first, user) {
+		t.Errorf("se perdió o movió la configuración del usuario:\n%s", first)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "config")); !os.IsNotExist(err) {
+		t.Errorf("se creó config junto a config.ghostty")
+	}
+	if again := configure(configPath); again != first {
+		t.Errorf("segunda pasada cambió config.ghostty:\n%s", again)
+	}
+
+	// Sin configuración se crea ~/.config/ghostty/config
+	os.Remove(configPath)
+	if created := configure(filepath.Join(dir, "config")); strings.Count(created, hook) != 1 {
+		t.Errorf("config nuevo:\n%s", created)
+	}
+}
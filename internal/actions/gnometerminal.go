@@ -0,0 +1,144 @@
+// Package: actions
+// Acciones de configuración de GNOME Terminal (perfil XEBEC vía dconf)
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+const (
+	// XebecGnomeProfileUUID UUID fijo del perfil XEBEC, para actualizarlo en lugar de duplicarlo
+	XebecGnomeProfileUUID = "5e8ec0de-7a6b-4c1d-9e2f-00aeef000002"
+	// GnomeProfilesPath ruta dconf de los perfiles de GNOME Terminal
+	GnomeProfilesPath = "/org/gnome/terminal/legacy/profiles:/"
+	// gnomeDefaultProfileUUID perfil que GNOME Terminal usa mientras list no está definida
+	gnomeDefaultProfileUUID = "b1dcc9dd-5262-4d8d-a863-c897e6d979b9"
+)
+
+// GnomeTerminalTemplateData datos para renderizar xebec.dconf
+type GnomeTerminalTemplateData struct {
+	ProfileTemplateData
+	UUID string
+}
+
+// GetGnomeTerminalDconfPath retorna el key file generado para `dconf load`
+func GetGnomeTerminalDconfPath() string {
+	return filepath.Join(GetXebecDataDir(), "gnome-terminal", "xebec.dconf")
+}
+
+// GetGnomeTerminalSourceDir retorna el directorio con la plantilla de GNOME Terminal
+func GetGnomeTerminalSourceDir() string {
+	_, currentFile, _, _ := runtime.Caller(0)
+	projectRoot := filepath.Dir(filepath.Dir(filepath.Dir(currentFile)))
+	return filepath.Join(projectRoot, "gnome-terminal")
+}
+
+// RenderGnomeTerminalProfile genera el key file del perfil XEBEC
+func RenderGnomeTerminalProfile(tmpl string, data GnomeTerminalTemplateData) (string, error) {
+	return renderTemplate("xebec.dconf", tmpl, data)
+}
+
+// AddGnomeProfileToList añade el UUID a la lista de perfiles (valor de dconf read)
+// Con la lista vacía conserva el perfil por defecto de GNOME Terminal
+func AddGnomeProfileToList(current, uuid string) string {
+	return addDconfProfileToList(current, uuid, gnomeDefaultProfileUUID)
+}
+
+// addDconfProfileToList añade uuid a una lista de perfiles; si la lista no está
+// definida parte del perfil por defecto para no ocultarlo
+func addDconfProfileToList(current, uuid, defaultUUID string) string {
+	list := parseGVariantStringList(current)
+	if len(list) == 0 {
+		list = []string{defaultUUID}
+	}
+	for _, id := range list {
+		if id == uuid {
+			return gvariantStringList(list)
+		}
+	}
+	return gvariantStringList(append(list, uuid))
+}
+
+// ConfigureGnomeTerminal genera el perfil XEBEC y lo carga con dconf
+// El key file queda en disco aunque dconf no esté disponible (p. ej. sin sesión gráfica)
+func ConfigureGnomeTerminal(opts TerminalProfileOptions) (err error) {
+	defer recordConfigured("gnome_terminal", opts.modules(), &err)
+
+	tmpl, err := os.ReadFile(filepath.Join(GetGnomeTerminalSourceDir(), "xebec.dconf"))
+	if err != nil {
+		return fmt.Errorf("error leyendo plantilla xebec.dconf: %w", err)
+	}
+
+	data := GnomeTerminalTemplateData{ProfileTemplateData: NewProfileTemplateData(opts), UUID: XebecGnomeProfileUUID}
+	if opts.Shell && data.Command == "" {
+		fmt.Println("⚠ Nushell no está instalado; el perfil usará el shell de login")
+	}
+	content, err := RenderGnomeTerminalProfile(string(tmpl), data)
+	if err != nil {
+		return fmt.Errorf("error generando configuración: %w", err)
+	}
+	dconfPath := GetGnomeTerminalDconfPath()
+	if err := writeFragment(dconfPath, content); err != nil {
+		return err
+	}
+
+	if _, err := exec.LookPath("dconf"); err != nil {
+		fmt.Printf("⚠ dconf no está disponible; cárgalo más tarde con: dconf load %s < %s\n", GnomeProfilesPath, dconfPath)
+		return nil
+	}
+	if err := loadDconfProfile(GnomeProfilesPath, XebecGnomeProfileUUID, gnomeDefaultProfileUUID, content); err != nil {
+		return err
+	}
+	fmt.Println("✓ Perfil XEBEC cargado en GNOME Terminal")
+	return nil
+}
+
+// loadDconfProfile carga un perfil con dconf y lo añade a la lista de perfiles de base
+// Sirve para GNOME Terminal y Tilix, que guardan los perfiles igual
+func loadDconfProfile(base, uuid, defaultUUID, content string) error {
+	// Reemplazar el perfil completo para que los grupos desmarcados vuelvan al valor por defecto
+	if out, err := exec.Command("dconf", "reset", "-f", base+":"+uuid+"/").CombinedOutput(); err != nil {
+		return fmt.Errorf("error ejecutando dconf reset: %s", strings.TrimSpace(string(out)))
+	}
+
+	load := exec.Command("dconf", "load", base)
+	load.Stdin = strings.NewReader(content)
+	if out, err := load.CombinedOutput(); err != nil {
+		return fmt.Errorf("error ejecutando dconf load: %s", strings.TrimSpace(string(out)))
+	}
+
+	current, err := exec.Command("dconf", "read", base+"list").Output()
+	if err != nil {
+		return fmt.Errorf("error ejecutando dconf read: %w", err)
+	}
+	list := addDconfProfileToList(string(current), uuid, defaultUUID)
+	if out, err := exec.Command("dconf", "write", base+"list", list).CombinedOutput(); err != nil {
+		return fmt.Errorf("error ejecutando dconf write: %s", strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// IsGnomeTerminalInstalled verifica si GNOME Terminal está instalado
+func IsGnomeTerminalInstalled() bool {
+	return isAnyBinaryInstalled([]string{"gnome-terminal", "gnome-terminal-server"})
+}
+
+// GetGnomeTerminalStatus retorna el estado actual de GNOME Terminal
+func GetGnomeTerminalStatus() (installed bool, configured bool, configPath string) {
+	configPath = GetGnomeTerminalDconfPath()
+	installed = IsGnomeTerminalInstalled()
+
+	if _, err := os.Stat(configPath); err == nil {
+		configured = true
+	}
+
+	return
+}
@@ -0,0 +1,59 @@
+package actions
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "regenerar los archivos de testdata/golden")
+
+// assertGolden compara got con testdata/golden/<name>; con -update lo reescribe
+func assertGolden(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", "golden", name)
+	if *updateGolden {
+		if err := os.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (genera los golden con go test ./internal/actions -update)", err)
+	}
+	if got != string(want) {
+		t.Errorf("%s no coincide:\n%s", name, UnifiedDiff(string(want), got, "golden/"+name, "generado"))
+	}
+}
+
+// goldenProfileData datos de plantilla fijos: tema integrado y Nushell en /usr/bin/nu
+func goldenProfileData(opts TerminalProfileOptions) ProfileTemplateData {
+	palette := DefaultTheme().TerminalPalette
+	data := ProfileTemplateData{
+		Modules: opts.modules(),
+		Palette: palette,
+		Colors:  append(palette.Normal.List(), palette.Bright.List()...),
+	}
+	if opts.Shell {
+		data.Program = "/usr/bin/nu"
+		data.Command = "/usr/bin/nu --login"
+	}
+	return data
+}
+
+// goldenHome fija HOME, XDG y PATH para que las rutas generadas no dependan de la máquina
+func goldenHome(t *testing.T) {
+	t.Helper()
+	if runtime.GOOS != "linux" {
+		t.Skip("las rutas de los golden son las de Linux")
+	}
+	t.Setenv("HOME", "/home/xebec")
+	t.Setenv("XDG_CONFIG_HOME", "/home/xebec/.config")
+	t.Setenv("XDG_CACHE_HOME", "/home/xebec/.cache")
+	t.Setenv("XDG_DATA_HOME", "/home/xebec/.local/share")
+	t.Setenv("ZDOTDIR", "")
+	t.Setenv("PATH", "")
+}
@@ -0,0 +1,422 @@
+// Package: actions
+// Historial de escrituras y detección de drift de los archivos gestionados por XEBEC
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Estados de drift de un archivo gestionado
+const (
+	DriftInSync           = "in-sync"           // Coincide con lo último que escribió XEBEC
+	DriftUserModified     = "user-modified"     // Editado después de que XEBEC lo escribiera
+	DriftOutdatedTemplate = "outdated-template" // Sin editar, pero la plantilla ha cambiado desde entonces
+	DriftMissing          = "missing"           // El archivo o el bloque ya no existe
+)
+
+// HistoryEntry escritura registrada en el historial
+type HistoryEntry struct {
+	Time      time.Time `json:"time"`
+	Tool      string    `json:"tool"`
+	Path      string    `json:"path"`
+	Component string    `json:"component,omitempty"` // Bloque gestionado; vacío si es el archivo completo
+	Hash      string    `json:"hash"`                // sha256 de lo escrito (archivo o bloque)
+	Template  string    `json:"template,omitempty"`  // TemplateHash del configurador al escribir
+}
+
+// FileDrift estado de un archivo (o bloque) gestionado
+type FileDrift struct {
+	Tool      string    `json:"tool"`
+	Path      string    `json:"path"`
+	Component string    `json:"component,omitempty"`
+	Status    string    `json:"status"`
+	WrittenAt time.Time `json:"written_at"`
+	entry     HistoryEntry
+}
+
+// pendingWrites escrituras de la configuración en curso; recordConfigured las vuelca al historial
+var pendingWrites []HistoryEntry
+
+// templateHashOf retorna el TemplateHash actual de una herramienta ("" si no tiene plantilla)
+// Se asigna en init: los configuradores registran el historial y a la vez forman Configurators
+var templateHashOf func(tool string) string
+
+func init() {
+	templateHashOf = func(tool string) string {
+		c, ok := FindConfigurator(tool)
+		if !ok {
+			return ""
+		}
+		hash, _ := TemplateHash(c)
+		return hash
+	}
+}
+
+// GetHistoryPath retorna la ruta del historial (una entrada JSON por línea)
+func GetHistoryPath() string {
+	return filepath.Join(GetXebecDataDir(), "history.jsonl")
+}
+
+// historyObjectPath retorna dónde se guarda el contenido escrito con un hash
+func historyObjectPath(hash string) string {
+	return filepath.Join(GetXebecDataDir(), "history", strings.TrimPrefix(hash, "sha256:"))
+}
+
+// trackWrite anota un archivo escrito por completo (o fusionado) por XEBEC
+func trackWrite(path, content string) {
+	trackContent(path, "", content)
+}
+
+// trackBlock anota un bloque gestionado tal y como queda en el archivo
+func trackBlock(path, component, body string) {
+	trackContent(path, component, SyntaxForPath(path).Format(component, body))
+}
+
+// trackContent guarda el contenido y añade la escritura a pendingWrites
+func trackContent(path, component, content string) {
+	hash := hashBytes([]byte(content))
+	object := historyObjectPath(hash)
+	if _, err := os.Stat(object); os.IsNotExist(err) {
+		if err := os.MkdirAll(filepath.Dir(object), 0755); err == nil {
+			os.WriteFile(object, []byte(content), 0644)
+		}
+	}
+	pendingWrites = append(pendingWrites, HistoryEntry{Time: time.Now(), Path: path, Component: component, Hash: hash})
+}
+
+// flushHistory añade al historial las escrituras pendientes atribuidas a tool
+// Con tool vacío (p. ej. al cambiar el shell por defecto) cada archivo conserva la
+// herramienta y la plantilla de su última escritura, para no marcarlo como editado
+func flushHistory(tool string) {
+	if len(pendingWrites) == 0 {
+		return
+	}
+	entries := pendingWrites
+	pendingWrites = nil
+
+	template := templateHashOf(tool)
+	var previous map[string]HistoryEntry
+	if tool == "" {
+		history, err := LoadHistory()
+		if err != nil {
+			fmt.Printf("⚠ %v\n", err)
+		}
+		previous = map[string]HistoryEntry{}
+		for _, entry := range history {
+			previous[entry.Path] = entry
+		}
+	}
+
+	path := GetHistoryPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		fmt.Printf("⚠ error creando directorio %s: %v\n", filepath.Dir(path), err)
+		return
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		fmt.Printf("⚠ error escribiendo historial: %v\n", err)
+		return
+	}
+	defer f.Close()
+	for _, entry := range entries {
+		entry.Tool, entry.Template = tool, template
+		if tool == "" {
+			entry.Tool = "default_shell"
+			if prev, ok := previous[entry.Path]; ok {
+				entry.Tool, entry.Template = prev.Tool, prev.Template
+			}
+		}
+		line, err := json.Marshal(entry)
+		if err != nil {
+			continue
+		}
+		f.Write(append(line, '\n'))
+	}
+}
+
+// LoadHistory lee el historial completo, de la escritura más antigua a la más reciente
+func LoadHistory() ([]HistoryEntry, error) {
+	f, err := os.Open(GetHistoryPath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error leyendo historial: %w", err)
+	}
+	defer f.Close()
+
+	var entries []HistoryEntry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var entry HistoryEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue // Línea truncada por una escritura interrumpida
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error leyendo historial: %w", err)
+	}
+	return entries, nil
+}
+
+// DetectDrift compara cada archivo gestionado con lo último que escribió XEBEC y con la plantilla actual
+func DetectDrift() ([]FileDrift, error) {
+	entries, err := LoadHistory()
+	if err != nil {
+		return nil, err
+	}
+
+	// Última escritura de cada archivo o bloque
+	// Un archivo que la misma herramienta escribió entero y después con bloques (wezterm.lua) se
+	// sigue por sus bloques; si los bloques son de otra (starship en config.nu) se siguen los dos
+	latest := map[string]HistoryEntry{}
+	for _, entry := range entries {
+		if whole, ok := latest[entry.Path+"\x00"]; ok && entry.Component != "" && whole.Tool == entry.Tool {
+			delete(latest, entry.Path+"\x00")
+		}
+		latest[entry.Path+"\x00"+entry.Component] = entry
+	}
+
+	templates := map[string]string{}
+	drifts := make([]FileDrift, 0, len(latest))
+	for _, entry := range latest {
+		current, ok, err := currentContent(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		status := DriftInSync
+		switch {
+		case !ok:
+			status = DriftMissing
+		case hashBytes([]byte(current)) != writtenHash(entry):
+			status = DriftUserModified
+		case entry.Template != "":
+			hash, cached := templates[entry.Tool]
+			if !cached {
+				hash = templateHashOf(entry.Tool)
+				templates[entry.Tool] = hash
+			}
+			if hash != "" && hash != entry.Template {
+				status = DriftOutdatedTemplate
+			}
+		}
+		drifts = append(drifts, FileDrift{Tool: entry.Tool, Path: entry.Path, Component: entry.Component, Status: status, WrittenAt: entry.Time, entry: entry})
+	}
+
+	sort.Slice(drifts, func(i, j int) bool {
+		if drifts[i].Tool != drifts[j].Tool {
+			return drifts[i].Tool < drifts[j].Tool
+		}
+		if drifts[i].Path != drifts[j].Path {
+			return drifts[i].Path < drifts[j].Path
+		}
+		return drifts[i].Component < drifts[j].Component
+	})
+	return drifts, nil
+}
+
+// currentContent retorna el contenido actual de una entrada (archivo o bloque)
+func currentContent(entry HistoryEntry) (string, bool, error) {
+	data, err := os.ReadFile(entry.Path)
+	if os.IsNotExist(err) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("error leyendo %s: %w", entry.Path, err)
+	}
+	if entry.Component == "" {
+		return withoutBlocks(entry.Path, string(data)), true, nil
+	}
+	block, ok := SyntaxForPath(entry.Path).Extract(string(data), entry.Component)
+	return block, ok, nil
+}
+
+// writtenContent retorna lo que escribió XEBEC, sin bloques si es un archivo completo
+func writtenContent(entry HistoryEntry) (string, error) {
+	data, err := os.ReadFile(historyObjectPath(entry.Hash))
+	if err != nil {
+		return "", err
+	}
+	if entry.Component == "" {
+		return withoutBlocks(entry.Path, string(data)), nil
+	}
+	return string(data), nil
+}
+
+// writtenHash hash con el que se compara el contenido actual
+// Sin el contenido guardado se usa el hash del historial tal cual
+func writtenHash(entry HistoryEntry) string {
+	if entry.Component == "" {
+		if written, err := writtenContent(entry); err == nil {
+			return hashBytes([]byte(written))
+		}
+	}
+	return entry.Hash
+}
+
+// withoutBlocks quita los bloques gestionados de un archivo completo
+// Cada bloque tiene su propia entrada: añadir el de starship a config.nu no edita el archivo de nushell
+func withoutBlocks(path, content string) string {
+	syntax := SyntaxForPath(path)
+	for _, block := range syntax.List(content) {
+		content, _ = syntax.Remove(content, block.Component)
+	}
+	return content
+}
+
+// Label nombre del archivo y, si es un bloque, su componente
+func (d FileDrift) Label() string {
+	if d.Component == "" {
+		return d.Path
+	}
+	return fmt.Sprintf("%s (xebec:%s)", d.Path, d.Component)
+}
+
+// Diff retorna las diferencias entre lo que escribió XEBEC y el contenido actual
+func (d FileDrift) Diff() (string, error) {
+	written, err := writtenContent(d.entry)
+	if err != nil {
+		return "", fmt.Errorf("no se conserva lo que XEBEC escribió en %s: %w", d.Path, err)
+	}
+	current, _, err := currentContent(d.entry)
+	if err != nil {
+		return "", err
+	}
+	return UnifiedDiff(written, current, "xebec:"+d.Label(), d.Label()), nil
+}
+
+// diffContext líneas sin cambios que se muestran alrededor de cada cambio
+const diffContext = 3
+
+// UnifiedDiff genera un diff unificado línea a línea entre a y b
+func UnifiedDiff(a, b, labelA, labelB string) string {
+	if a == b {
+		return ""
+	}
+	linesA, linesB := diffLines(a), diffLines(b)
+	ops := diffOps(linesA, linesB)
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s\n+++ %s\n", labelA, labelB)
+	for start := 0; start < len(ops); {
+		// Buscar el siguiente cambio
+		for start < len(ops) && ops[start].kind == ' ' {
+			start++
+		}
+		if start >= len(ops) {
+			break
+		}
+		from := max(start-diffContext, 0)
+		end := start
+		for end < len(ops) {
+			if ops[end].kind != ' ' {
+				end++
+				continue
+			}
+			// Cortar el bloque si hay más de 2*contexto líneas iguales seguidas
+			run := end
+			for run < len(ops) && ops[run].kind == ' ' {
+				run++
+			}
+			if run-end > 2*diffContext || run == len(ops) {
+				end = min(end+diffContext, len(ops))
+				break
+			}
+			end = run
+		}
+
+		hunk := ops[from:end]
+		lineA, lineB := hunk[0].a+1, hunk[0].b+1
+		countA, countB := 0, 0
+		for _, op := range hunk {
+			if op.kind != '+' {
+				countA++
+			}
+			if op.kind != '-' {
+				countB++
+			}
+		}
+		// Un lado vacío se numera con la línea anterior, como en diff -u
+		if countA == 0 {
+			lineA--
+		}
+		if countB == 0 {
+			lineB--
+		}
+		fmt.Fprintf(&out, "@@ -%d,%d +%d,%d @@\n", lineA, countA, lineB, countB)
+		for _, op := range hunk {
+			out.WriteByte(op.kind)
+			out.WriteString(op.text)
+			out.WriteByte('\n')
+		}
+		start = end
+	}
+	return out.String()
+}
+
+// diffOp línea del diff: ' ' igual, '-' solo en a, '+' solo en b (a y b son índices de línea)
+type diffOp struct {
+	kind byte
+	text string
+	a, b int
+}
+
+// diffLines separa en líneas ignorando el salto final
+func diffLines(s string) []string {
+	s = strings.TrimSuffix(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
+
+// diffOps calcula las operaciones con la subsecuencia común más larga
+// Archivos de configuración: unas pocas miles de líneas como mucho
+func diffOps(a, b []string) []diffOp {
+	n, m := len(a), len(b)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var ops []diffOp
+	i, j := 0, 0
+	for i < n || j < m {
+		switch {
+		case i < n && j < m && a[i] == b[j]:
+			ops = append(ops, diffOp{' ', a[i], i, j})
+			i++
+			j++
+		// En un empate primero la línea quitada, como en diff -u
+		case i < n && (j == m || lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, diffOp{'-', a[i], i, j})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', b[j], i, j})
+			j++
+		}
+	}
+	return ops
+}
@@ -0,0 +1,257 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// stubTemplateHash fija el TemplateHash que devuelve cada herramienta
+func stubTemplateHash(t *testing.T, hashes map[string]string) {
+	t.Helper()
+	saved := templateHashOf
+	templateHashOf = func(tool string) string { return hashes[tool] }
+	t.Cleanup(func() { templateHashOf = saved })
+}
+
+func writeTestFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDiffOps(t *testing.T) {
+	ops := diffOps([]string{"a", "b", "c", "d"}, []string{"a", "x", "c", "d", "e"})
+	var kinds, texts string
+	for _, op := range ops {
+		kinds += string(op.kind)
+		texts += op.text
+	}
+	if kinds != " -+  +" || texts != "abxcde" {
+		t.Errorf("diffOps() = %q %q, want \" -+  +\" \"abxcde\"", kinds, texts)
+	}
+	if ops := diffOps(nil, nil); len(ops) != 0 {
+		t.Errorf("diffOps(nil, nil) = %v", ops)
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	lines := func(n int, change map[int]string) string {
+		var s string
+		for i := 1; i <= n; i++ {
+			line, ok := change[i]
+			if !ok {
+				line = string(rune('a' + i - 1))
+			}
+			s += line + "\n"
+		}
+		return s
+	}
+
+	tests := []struct {
+		name, a, b, want string
+	}{
+		{"iguales", "a\nb\n", "a\nb\n", ""},
+		{"solo CRLF", "a\r\nb\r\n", "a\nb\n", "--- a\n+++ b\n"},
+		{
+			"cambio con contexto",
+			lines(10, nil), lines(10, map[int]string{5: "X"}),
+			"--- a\n+++ b\n@@ -2,7 +2,7 @@\n b\n c\n d\n-e\n+X\n f\n g\n h\n",
+		},
+		{
+			"cambios lejanos en dos bloques",
+			lines(20, nil), lines(20, map[int]string{2: "X", 18: "Y"}),
+			"--- a\n+++ b\n@@ -1,5 +1,5 @@\n a\n-b\n+X\n c\n d\n e\n@@ -15,6 +15,6 @@\n o\n p\n q\n-r\n+Y\n s\n t\n",
+		},
+		{"archivo nuevo", "", "a\nb\n", "--- a\n+++ b\n@@ -0,0 +1,2 @@\n+a\n+b\n"},
+		{"archivo vaciado", "a\n", "", "--- a\n+++ b\n@@ -1,1 +0,0 @@\n-a\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnifiedDiff(tt.a, tt.b, "a", "b"); got != tt.want {
+				t.Errorf("UnifiedDiff() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlushHistory(t *testing.T) {
+	setupPlanHome(t)
+	stubTemplateHash(t, map[string]string{"kitty": "sha256:kitty-v1"})
+	dir := t.TempDir()
+	conf := filepath.Join(dir, "xebec.conf")
+	other := filepath.Join(dir, "alacritty.toml")
+
+	trackWrite(conf, "font_size 13\n")
+	flushHistory("kitty")
+	if pendingWrites != nil {
+		t.Errorf("pendingWrites sin vaciar: %v", pendingWrites)
+	}
+	if _, err := os.Stat(historyObjectPath(hashBytes([]byte("font_size 13\n")))); err != nil {
+		t.Errorf("no se guardó el contenido escrito: %v", err)
+	}
+
+	// Sin herramienta (shell por defecto) se conserva la de la última escritura
+	trackWrite(conf, "font_size 13\nshell nu\n")
+	trackWrite(other, "[terminal.shell]\n")
+	flushHistory("")
+
+	entries, err := LoadHistory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("LoadHistory() = %d entradas, want 3", len(entries))
+	}
+	want := []struct{ path, tool, template string }{
+		{conf, "kitty", "sha256:kitty-v1"},
+		{conf, "kitty", "sha256:kitty-v1"},
+		{other, "default_shell", ""},
+	}
+	for i, w := range want {
+		e := entries[i]
+		if e.Path != w.path || e.Tool != w.tool || e.Template != w.template {
+			t.Errorf("entrada %d = %s %s %q, want %s %s %q", i, e.Path, e.Tool, e.Template, w.path, w.tool, w.template)
+		}
+	}
+
+	// Una línea truncada no impide leer el resto
+	f, err := os.OpenFile(GetHistoryPath(), os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString("{\"time\":\n")
+	f.Close()
+	if entries, err := LoadHistory(); err != nil || len(entries) != 3 {
+		t.Errorf("LoadHistory() con línea truncada = %d, %v", len(entries), err)
+	}
+}
+
+func TestDetectDrift(t *testing.T) {
+	setupPlanHome(t)
+	hashes := map[string]string{"kitty": "sha256:v1", "zsh": "sha256:v1"}
+	stubTemplateHash(t, hashes)
+	dir := t.TempDir()
+
+	inSync := filepath.Join(dir, "in-sync.conf")
+	edited := filepath.Join(dir, "edited.conf")
+	missing := filepath.Join(dir, "missing.conf")
+	zshrc := filepath.Join(dir, ".zshrc")
+	for _, path := range []string{inSync, edited, missing} {
+		writeTestFile(t, path, "font_size 13\n")
+		trackWrite(path, "font_size 13\n")
+	}
+	flushHistory("kitty")
+
+	// Escrito entero y después por bloques: solo cuenta el bloque
+	trackWrite(zshrc, "export A=1\n")
+	block := SyntaxForPath(zshrc).Format("colors", "export LS_COLORS=x")
+	writeTestFile(t, zshrc, "export A=1\n"+block)
+	trackBlock(zshrc, "colors", "export LS_COLORS=x")
+	flushHistory("zsh")
+
+	writeTestFile(t, edited, "font_size 14\n")
+	os.Remove(missing)
+	hashes["zsh"] = "sha256:v2"
+
+	drifts, err := DetectDrift()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		inSync:            DriftInSync,
+		edited:            DriftUserModified,
+		missing:           DriftMissing,
+		zshrc + ":colors": DriftOutdatedTemplate,
+	}
+	if len(drifts) != len(want) {
+		t.Fatalf("DetectDrift() = %d archivos, want %d: %+v", len(drifts), len(want), drifts)
+	}
+	for _, d := range drifts {
+		key := d.Path
+		if d.Component != "" {
+			key += ":" + d.Component
+		}
+		if d.Status != want[key] {
+			t.Errorf("%s = %s, want %s", d.Label(), d.Status, want[key])
+		}
+		if d.Status == DriftUserModified {
+			diff, err := d.Diff()
+			if err != nil {
+				t.Fatal(err)
+			}
+			wantDiff := "--- xebec:" + edited + "\n+++ " + edited + "\n@@ -1,1 +1,1 @@\n-font_size 13\n+font_size 14\n"
+			if diff != wantDiff {
+				t.Errorf("Diff() =\n%s\nwant\n%s", diff, wantDiff)
+			}
+		}
+	}
+
+	// Un bloque borrado por el usuario queda como missing
+	writeTestFile(t, zshrc, "export A=1\n")
+	drifts, err = DetectDrift()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range drifts {
+		if d.Path == zshrc && d.Status != DriftMissing {
+			t.Errorf("bloque borrado = %s, want %s", d.Status, DriftMissing)
+		}
+	}
+}
+
+func TestDetectDriftSharedFile(t *testing.T) {
+	setupPlanHome(t)
+	stubTemplateHash(t, map[string]string{})
+	configNu := filepath.Join(t.TempDir(), "config.nu")
+
+	// nushell escribe config.nu entero y starship añade después su bloque
+	writeTestFile(t, configNu, "$env.config.show_banner = false\n")
+	trackWrite(configNu, "$env.config.show_banner = false\n")
+	flushHistory("nushell")
+	if _, err := UpsertBlockInFile(configNu, "starship", "use ~/.cache/starship/init.nu"); err != nil {
+		t.Fatal(err)
+	}
+	flushHistory("starship")
+
+	status := func() map[string]FileDrift {
+		t.Helper()
+		drifts, err := DetectDrift()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := map[string]FileDrift{}
+		for _, d := range drifts {
+			got[d.Tool] = d
+		}
+		return got
+	}
+
+	got := status()
+	if len(got) != 2 || got["nushell"].Status != DriftInSync || got["starship"].Status != DriftInSync {
+		t.Fatalf("DetectDrift() = %+v, want nushell y starship in-sync", got)
+	}
+
+	// Una edición fuera del bloque es del archivo de nushell, no del bloque
+	data, err := os.ReadFile(configNu)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, configNu, "$env.config.show_banner = true\n"+string(data)[len("$env.config.show_banner = false\n"):])
+	got = status()
+	if got["nushell"].Status != DriftUserModified || got["starship"].Status != DriftInSync {
+		t.Errorf("tras editar config.nu: nushell %s, starship %s", got["nushell"].Status, got["starship"].Status)
+	}
+	diff, err := got["nushell"].Diff()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantDiff := "--- xebec:" + configNu + "\n+++ " + configNu + "\n@@ -1,1 +1,1 @@\n-$env.config.show_banner = false\n+$env.config.show_banner = true\n"
+	if diff != wantDiff {
+		t.Errorf("Diff() =\n%s\nwant\n%s", diff, wantDiff)
+	}
+}
@@ -0,0 +1,164 @@
+// Package: actions
+// Edición de archivos INI / key files (terminalrc de XFCE, foot.ini, config.toml de Rio)
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// INIKey una clave con su valor dentro de una sección
+type INIKey struct {
+	Section string
+	Key     string
+	Value   string
+}
+
+// ParseINI lee las claves de un INI en orden, ignorando comentarios y líneas vacías
+func ParseINI(content string) []INIKey {
+	var keys []INIKey
+	section := ""
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			section = strings.TrimSpace(line[1 : len(line)-1])
+		default:
+			if k, v, ok := strings.Cut(line, "="); ok {
+				keys = append(keys, INIKey{Section: section, Key: strings.TrimSpace(k), Value: strings.TrimSpace(v)})
+			}
+		}
+	}
+	return keys
+}
+
+// SetINIValues fija claves en un INI conservando el resto del archivo
+// Las claves existentes se reemplazan en su sitio; las nuevas se añaden al final
+// de su sección, y las secciones que faltan al final del archivo
+func SetINIValues(content string, values []INIKey) string {
+	eol, lines := splitContent(content)
+	pending := append([]INIKey{}, values...)
+	sep := iniSeparator(lines)
+
+	set := func(section, key string) (string, bool) {
+		for i, v := range pending {
+			if v.Section == section && v.Key == key {
+				pending = append(pending[:i], pending[i+1:]...)
+				return v.Value, true
+			}
+		}
+		return "", false
+	}
+	flush := func(section string) []string {
+		var out []string
+		for i := 0; i < len(pending); {
+			if pending[i].Section == section {
+				out = append(out, pending[i].Key+sep+pending[i].Value)
+				pending = append(pending[:i], pending[i+1:]...)
+				continue
+			}
+			i++
+		}
+		return out
+	}
+
+	var out []string
+	section := ""
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "[") && strings.HasSuffix(trimmed, "]") {
+			// Claves nuevas de la sección anterior, antes de las líneas vacías finales
+			end := len(out)
+			for end > 0 && strings.TrimSpace(out[end-1]) == "" {
+				end--
+			}
+			added := flush(section)
+			if section == "" && len(added) > 0 {
+				added = append(added, "")
+			}
+			out = append(out[:end], append(added, out[end:]...)...)
+			section = strings.TrimSpace(trimmed[1 : len(trimmed)-1])
+			out = append(out, line)
+			continue
+		}
+		if k, _, ok := strings.Cut(trimmed, "="); ok && !strings.HasPrefix(trimmed, "#") && !strings.HasPrefix(trimmed, ";") {
+			if value, ok := set(section, strings.TrimSpace(k)); ok {
+				out = append(out, strings.TrimSpace(k)+sep+value)
+				continue
+			}
+		}
+		out = append(out, line)
+	}
+	out = append(out, flush(section)...)
+
+	for len(pending) > 0 {
+		name := pending[0].Section
+		if len(out) > 0 && strings.TrimSpace(out[len(out)-1]) != "" {
+			out = append(out, "")
+		}
+		out = append(out, "["+name+"]")
+		out = append(out, flush(name)...)
+	}
+	return joinContent(out, eol)
+}
+
+// iniSeparator retorna el separador que usa el archivo ("key = value" en TOML, "key=value" si no)
+func iniSeparator(lines []string) string {
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, ";") || strings.HasPrefix(trimmed, "[") {
+			continue
+		}
+		if k, _, ok := strings.Cut(trimmed, "="); ok {
+			if strings.HasSuffix(k, " ") {
+				return " = "
+			}
+			return "="
+		}
+	}
+	return "="
+}
+
+// mergeINIFile fija en path las claves de un INI renderizado, con backup si cambia
+func mergeINIFile(path, rendered string) error {
+	current, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error leyendo %s: %w", path, err)
+	}
+	updated := SetINIValues(string(current), ParseINI(rendered))
+	if updated == string(current) {
+		trackWrite(path, updated)
+		fmt.Printf("• %s ya está actualizado\n", path)
+		return nil
+	}
+
+	var result BlockResult
+	if err := writeWithBackup(path, updated, &result); err != nil {
+		return err
+	}
+	trackWrite(path, updated)
+	if result.BackupPath != "" {
+		fmt.Printf("✓ Backup creado: %s\n", result.BackupPath)
+	}
+	fmt.Printf("✓ Configuración aplicada: %s\n", path)
+	return nil
+}
+
+// iniHasValue indica si el archivo tiene la clave con ese valor en la sección
+func iniHasValue(path, section, key, value string) bool {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	for _, k := range ParseINI(string(data)) {
+		if k.Section == section && k.Key == key && strings.EqualFold(k.Value, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,303 @@
+// Package: actions
+// Lectura y edición de JSON con comentarios (settings.json de Windows Terminal)
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// StripJSONC elimina comentarios // y /* */ y comas finales para poder usar encoding/json
+func StripJSONC(data []byte) []byte {
+	var out bytes.Buffer
+	inString := false
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		if inString {
+			out.WriteByte(c)
+			if c == '\\' && i+1 < len(data) {
+				i++
+				out.WriteByte(data[i])
+			} else if c == '"' {
+				inString = false
+			}
+			continue
+		}
+
+		switch {
+		case c == '"':
+			inString = true
+			out.WriteByte(c)
+		case c == '/' && i+1 < len(data) && data[i+1] == '/':
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+			if i < len(data) {
+				out.WriteByte('\n')
+			}
+		case c == '/' && i+1 < len(data) && data[i+1] == '*':
+			i += 2
+			for i+1 < len(data) && !(data[i] == '*' && data[i+1] == '/') {
+				i++
+			}
+			i++
+		default:
+			out.WriteByte(c)
+		}
+	}
+	return stripTrailingCommas(out.Bytes())
+}
+
+// stripTrailingCommas elimina comas antes de } o ] (fuera de cadenas)
+func stripTrailingCommas(data []byte) []byte {
+	var out bytes.Buffer
+	inString := false
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		if inString {
+			out.WriteByte(c)
+			if c == '\\' && i+1 < len(data) {
+				i++
+				out.WriteByte(data[i])
+			} else if c == '"' {
+				inString = false
+			}
+			continue
+		}
+		if c == '"' {
+			inString = true
+		}
+		if c == ',' {
+			j := i + 1
+			for j < len(data) && (data[j] == ' ' || data[j] == '\t' || data[j] == '\r' || data[j] == '\n') {
+				j++
+			}
+			if j < len(data) && (data[j] == '}' || data[j] == ']') {
+				continue
+			}
+		}
+		out.WriteByte(c)
+	}
+	return out.Bytes()
+}
+
+// jsoncSkip salta una cadena o un comentario que empieza en i y retorna el índice
+// de su último carácter; si en i no empieza ninguno retorna i
+func jsoncSkip(content string, i int) int {
+	switch {
+	case content[i] == '"':
+		for j := i + 1; j < len(content); j++ {
+			if content[j] == '\\' {
+				j++
+			} else if content[j] == '"' {
+				return j
+			}
+		}
+		return len(content) - 1
+	case strings.HasPrefix(content[i:], "//"):
+		if end := strings.IndexByte(content[i:], '\n'); end >= 0 {
+			return i + end - 1
+		}
+		return len(content) - 1
+	case strings.HasPrefix(content[i:], "/*"):
+		if end := strings.Index(content[i+2:], "*/"); end >= 0 {
+			return i + 2 + end + 1
+		}
+		return len(content) - 1
+	}
+	return i
+}
+
+// jsoncNext retorna el índice del siguiente carácter significativo desde i
+// (sin espacios ni comentarios), o -1 si no hay más
+func jsoncNext(content string, i int) int {
+	for ; i < len(content); i++ {
+		switch c := content[i]; {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+		case c == '/' && (strings.HasPrefix(content[i:], "//") || strings.HasPrefix(content[i:], "/*")):
+			i = jsoncSkip(content, i)
+		default:
+			return i
+		}
+	}
+	return -1
+}
+
+// jsoncFindKey busca una clave de primer nivel en el objeto que abre en obj
+// y retorna el índice donde empieza su valor
+func jsoncFindKey(content string, obj int, key string) (int, bool) {
+	depth := 0
+	for i := obj; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case c == '"':
+			end := jsoncSkip(content, i)
+			if depth == 1 && content[i+1:end] == key {
+				if colon := jsoncNext(content, end+1); colon >= 0 && content[colon] == ':' {
+					if value := jsoncNext(content, colon+1); value >= 0 {
+						return value, true
+					}
+				}
+			}
+			i = end
+		case c == '/':
+			i = jsoncSkip(content, i)
+		case c == '{' || c == '[':
+			depth++
+		case c == '}' || c == ']':
+			depth--
+			if depth == 0 {
+				return 0, false
+			}
+		}
+	}
+	return 0, false
+}
+
+// jsoncValueEnd retorna el índice del último carácter del valor que empieza en i
+func jsoncValueEnd(content string, i int) int {
+	switch content[i] {
+	case '"':
+		return jsoncSkip(content, i)
+	case '{', '[':
+		depth := 0
+		for j := i; j < len(content); j++ {
+			switch c := content[j]; {
+			case c == '"' || c == '/':
+				j = jsoncSkip(content, j)
+			case c == '{' || c == '[':
+				depth++
+			case c == '}' || c == ']':
+				depth--
+				if depth == 0 {
+					return j
+				}
+			}
+		}
+		return len(content) - 1
+	}
+	end := i
+	for end+1 < len(content) && !strings.ContainsRune(",}] \t\r\n/", rune(content[end+1])) {
+		end++
+	}
+	return end
+}
+
+// jsoncSetKey fija el valor de una clave de primer nivel del objeto que abre en obj
+// Si la clave no existe se añade al principio con la indentación de sus hermanas;
+// si ya es un objeto y value también, se fijan solo sus claves
+func jsoncSetKey(content string, obj int, key string, value json.RawMessage) string {
+	indented := func(prefix string) string {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, value, prefix, "    "); err != nil {
+			return string(value)
+		}
+		return strings.ReplaceAll(buf.String(), "\n", eolOf(content))
+	}
+
+	if start, ok := jsoncFindKey(content, obj, key); ok {
+		// Objeto dentro de objeto (font): se combinan sus claves igual
+		if members, err := jsonMembers(value); err == nil && content[start] == '{' {
+			for i := len(members) - 1; i >= 0; i-- {
+				content = jsoncSetKey(content, start, members[i].Key, members[i].Value)
+			}
+			return content
+		}
+		return content[:start] + indented(lineIndent(content, start)) + content[jsoncValueEnd(content, start)+1:]
+	}
+
+	name, _ := json.Marshal(key)
+	next := jsoncNext(content, obj+1)
+	switch {
+	case next < 0:
+		return content
+	case content[next] == '}':
+		return content[:obj+1] + string(name) + ": " + string(value) + content[obj+1:]
+	case strings.Contains(content[obj:next], "\n"):
+		// Objeto de varias líneas: nueva línea con la indentación de la primera clave
+		indent := lineIndent(content, next)
+		return content[:obj+1] + eolOf(content) + indent + string(name) + ": " + indented(indent) + "," + content[obj+1:]
+	}
+	return content[:obj+1] + " " + string(name) + ": " + string(value) + "," + content[obj+1:]
+}
+
+// jsonMember clave y valor serializado de un objeto, en el orden de sus campos
+type jsonMember struct {
+	Key   string
+	Value json.RawMessage
+}
+
+// jsonMembers serializa v (un struct u objeto) y retorna sus claves en orden
+func jsonMembers(v interface{}) ([]jsonMember, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, fmt.Errorf("se esperaba un objeto JSON")
+	}
+	var members []jsonMember
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+		members = append(members, jsonMember{Key: tok.(string), Value: value})
+	}
+	return members, nil
+}
+
+// jsoncArrayObjects retorna el rango [inicio, fin] de cada objeto del array que abre en open
+// y el índice del ']' que lo cierra
+func jsoncArrayObjects(content string, open int) ([][2]int, int, error) {
+	var spans [][2]int
+	depth, start := 0, 0
+	for i := open + 1; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case c == '"' || c == '/':
+			i = jsoncSkip(content, i)
+		case c == '{' || c == '[':
+			if depth == 0 {
+				start = i
+			}
+			depth++
+		case c == ']' && depth == 0:
+			return spans, i, nil
+		case c == '}' || c == ']':
+			depth--
+			if depth == 0 && c == '}' {
+				spans = append(spans, [2]int{start, i})
+			}
+		}
+	}
+	return nil, 0, fmt.Errorf("array sin cerrar")
+}
+
+// lineIndent retorna la indentación de la línea que contiene el índice i
+func lineIndent(content string, i int) string {
+	line := content[strings.LastIndexByte(content[:i], '\n')+1:]
+	return line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+}
+
+// newGUID genera un GUID v4 con llaves, como los usa Windows Terminal
+func newGUID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "{00000000-0000-4000-8000-000000000000}"
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("{%x-%x-%x-%x-%x}", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
@@ -0,0 +1,217 @@
+// Package: actions
+// Acciones de configuración de Kitty
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// Opciones de configuración de Kitty (mismos grupos que Alacritty)
+type KittyConfigOptions struct {
+	Window bool // hide_window_decorations, background_opacity, padding
+	Colors bool // foreground, background, color0-color15
+	Font   bool // font_family, font_size
+	Cursor bool // cursor_shape, cursor_blink_interval
+	Shell  bool // shell
+	Reload bool // kitty @ load-config en las instancias abiertas
+}
+
+// GetKittyConfigOptions retorna las opciones disponibles para configurar
+func GetKittyConfigOptions() []ConfigOption {
+	return []ConfigOption{
+		{ID: "window", Title: "Ventana", Description: "Sin decoraciones, opacity, padding", Key: "window"},
+		{ID: "colors", Title: "Colores", Description: "Tema XEBEC - color0-15, cursor, selección", Key: "colors"},
+		{ID: "font", Title: "Fuente", Description: "JetBrains Mono, tamaño", Key: "font"},
+		{ID: "cursor", Title: "Cursor", Description: "Barra parpadeante", Key: "cursor"},
+		{ID: "shell", Title: "Shell", Description: "shell con Nushell", Key: "shell"},
+		{ID: "reload", Title: "Recargar", Description: "kitty @ load-config (requiere remote control)", Key: "reload"},
+	}
+}
+
+// KittyOptionsFromIDs construye las opciones a partir de los IDs marcados
+func KittyOptionsFromIDs(ids []string) KittyConfigOptions {
+	return KittyConfigOptions{
+		Window: containsID(ids, "window"),
+		Colors: containsID(ids, "colors"),
+		Font:   containsID(ids, "font"),
+		Cursor: containsID(ids, "cursor"),
+		Shell:  containsID(ids, "shell"),
+		Reload: containsID(ids, "reload"),
+	}
+}
+
+// IsEmpty indica si no se seleccionó ningún grupo de configuración
+func (o KittyConfigOptions) IsEmpty() bool {
+	return !o.Window && !o.Colors && !o.Font && !o.Cursor && !o.Shell
+}
+
+// modules retorna el mapa de módulos usado por la plantilla
+func (o KittyConfigOptions) modules() map[string]bool {
+	return map[string]bool{
+		"window": o.Window,
+		"colors": o.Colors,
+		"font":   o.Font,
+		"cursor": o.Cursor,
+		"shell":  o.Shell,
+	}
+}
+
+// kittyModules opciones marcadas por ID, incluida la recarga
+func kittyModules(opts KittyConfigOptions) map[string]bool {
+	modules := opts.modules()
+	modules["reload"] = opts.Reload
+	return modules
+}
+
+// KittyTemplateData datos para renderizar xebec.conf
+type KittyTemplateData struct {
+	Modules  map[string]bool
+	ConfPath string
+	Palette  TerminalPalette
+	Colors   []string // color0-color15
+	Shell    string   // Línea de comandos del shell; vacía si no está instalado
+}
+
+// GetKittyConfigDir retorna el directorio de configuración de Kitty
+func GetKittyConfigDir() string {
+	if dir := os.Getenv("KITTY_CONFIG_DIRECTORY"); dir != "" {
+		return dir
+	}
+	return filepath.Join(xdgConfigHome(), "kitty")
+}
+
+// GetKittyConfigPath retorna la ruta de kitty.conf
+func GetKittyConfigPath() string {
+	return filepath.Join(GetKittyConfigDir(), "kitty.conf")
+}
+
+// GetKittyXebecPath retorna la ruta de xebec.conf
+func GetKittyXebecPath() string {
+	return filepath.Join(GetKittyConfigDir(), "xebec.conf")
+}
+
+// GetKittySourceDir retorna el directorio con la plantilla de Kitty
+func GetKittySourceDir() string {
+	_, currentFile, _, _ := runtime.Caller(0)
+	projectRoot := filepath.Dir(filepath.Dir(filepath.Dir(currentFile)))
+	return filepath.Join(projectRoot, "kitty")
+}
+
+// NewKittyTemplateData construye los datos de plantilla para la máquina actual
+func NewKittyTemplateData(opts KittyConfigOptions) KittyTemplateData {
+	data := KittyTemplateData{
+		Modules:  opts.modules(),
+		ConfPath: GetKittyXebecPath(),
+		Palette:  XebecPalette(),
+		Colors:   append(XebecPalette().Normal.List(), XebecPalette().Bright.List()...),
+	}
+	// Nushell con --login, igual que la plantilla de Alacritty
+	if shell, err := FindShell("nu"); err == nil {
+		if program, err := ResolveShellProgram(shell); err == nil {
+			data.Shell = posixQuote(program) + " --login"
+		}
+	}
+	return data
+}
+
+// RenderKittyConfig genera xebec.conf a partir de la plantilla
+func RenderKittyConfig(tmpl string, data KittyTemplateData) (string, error) {
+	return renderTemplate("xebec.conf", tmpl, data)
+}
+
+// ConfigureKitty genera xebec.conf y lo incluye desde kitty.conf
+func ConfigureKitty(opts KittyConfigOptions) (err error) {
+	defer recordConfigured("kitty", kittyModules(opts), &err)
+
+	// Verificar que Kitty esté instalado
+	if !IsKittyInstalled() {
+		return fmt.Errorf("Kitty no está instalado en el sistema")
+	}
+
+	tmpl, err := os.ReadFile(filepath.Join(GetKittySourceDir(), "xebec.conf"))
+	if err != nil {
+		return fmt.Errorf("error leyendo plantilla xebec.conf: %w", err)
+	}
+
+	data := NewKittyTemplateData(opts)
+	if opts.Shell && data.Shell == "" {
+		fmt.Println("⚠ Nushell no está instalado; shell no se modifica")
+	}
+	content, err := RenderKittyConfig(string(tmpl), data)
+	if err != nil {
+		return fmt.Errorf("error generando configuración: %w", err)
+	}
+	if err := writeFragment(data.ConfPath, content); err != nil {
+		return err
+	}
+
+	// Un único include al final: las opciones de xebec.conf prevalecen sobre las anteriores
+	if err := upsertBlocks(GetKittyConfigPath(), []ManagedBlock{{Component: "kitty", Body: "include xebec.conf"}}); err != nil {
+		return err
+	}
+
+	if opts.Reload {
+		if err := ReloadKitty(); err != nil {
+			fmt.Printf("⚠ %v\n", err)
+			fmt.Println("  Recarga a mano con ctrl+shift+F5 o reinicia Kitty")
+		} else {
+			fmt.Println("✓ Configuración recargada en las instancias de Kitty")
+		}
+	}
+	return nil
+}
+
+// ReloadKitty recarga la configuración con `kitty @ load-config`
+// Requiere allow_remote_control; fuera de Kitty también listen_on ($KITTY_LISTEN_ON)
+func ReloadKitty() error {
+	args := []string{"@"}
+	if to := os.Getenv("KITTY_LISTEN_ON"); to != "" {
+		args = append(args, "--to", to)
+	} else if os.Getenv("KITTY_WINDOW_ID") == "" {
+		return fmt.Errorf("no se puede recargar Kitty: no estás en Kitty y KITTY_LISTEN_ON no está definido")
+	}
+	args = append(args, "load-config")
+
+	out, err := exec.Command("kitty", args...).CombinedOutput()
+	if err != nil {
+		msg := strings.TrimSpace(string(out))
+		if msg == "" {
+			msg = err.Error()
+		}
+		return fmt.Errorf("error ejecutando kitty @ load-config (¿allow_remote_control activado?): %s", msg)
+	}
+	return nil
+}
+
+// IsKittyInstalled verifica si Kitty está instalado
+func IsKittyInstalled() bool {
+	if isAnyBinaryInstalled([]string{"kitty"}) {
+		return true
+	}
+	if runtime.GOOS == "darwin" {
+		if _, err := os.Stat("/Applications/kitty.app"); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// GetKittyStatus retorna el estado actual de Kitty
+func GetKittyStatus() (installed bool, configured bool, configPath string) {
+	configPath = GetKittyXebecPath()
+	installed = IsKittyInstalled()
+
+	if _, err := os.Stat(configPath); err == nil {
+		configured = true
+	}
+
+	return
+}
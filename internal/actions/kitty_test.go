@@ -0,0 +1,89 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRenderKittyConfigGolden(t *testing.T) {
+	goldenHome(t)
+	t.Setenv("KITTY_CONFIG_DIRECTORY", "")
+	tmpl, err := os.ReadFile(filepath.Join(GetKittySourceDir(), "xebec.conf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		ids  []string
+	}{
+		{name: "all", ids: Configurator{Options: GetKittyConfigOptions}.DefaultSections()},
+		{name: "colors", ids: []string{"colors"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := NewKittyTemplateData(KittyOptionsFromIDs(tt.ids))
+			// El shell real se busca en PATH; aquí una ruta fija
+			data.Shell = "/usr/bin/nu --login"
+			out, err := RenderKittyConfig(string(tmpl), data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertGolden(t, "kitty-"+tt.name+".conf", out)
+		})
+	}
+}
+
+func TestConfigureKittyInclude(t *testing.T) {
+	setupPlanHome(t)
+	t.Setenv("KITTY_CONFIG_DIRECTORY", "")
+	fakeBinaries(t, "kitty")
+	opts := KittyOptionsFromIDs([]string{"colors"})
+	configPath := GetKittyConfigPath()
+
+	configure := func() string {
+		t.Helper()
+		if err := ConfigureKitty(opts); err != nil {
+			t.Fatal(err)
+		}
+		data, err := os.ReadFile(configPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	includes := func(content string) int {
+		n := 0
+		for _, line := range strings.Split(content, "\n") {
+			if strings.TrimSpace(line) == "include xebec.conf" {
+				n++
+			}
+		}
+		return n
+	}
+
+	// El include va después de las opciones del usuario para que xebec.conf prevalezca
+	user := "font_size 15\ninclude theme.conf\n"
+	writeTestFile(t, configPath, user)
+	first := configure()
+	if n := includes(first); n != 1 {
+		t.Fatalf("%d include xebec.conf, want 1:\n%s", n, first)
+	}
+	if !strings.HasPrefix(first, user) {
+		t.Errorf("se perdió o movió la configuración del usuario:\n%s", first)
+	}
+	if _, err := os.Stat(GetKittyXebecPath()); err != nil {
+		t.Errorf("no se generó xebec.conf: %v", err)
+	}
+	if again := configure(); again != first {
+		t.Errorf("segunda pasada cambió kitty.conf:\n%s", again)
+	}
+
+	// Sin kitty.conf se crea solo con el include
+	os.Remove(configPath)
+	if created := configure(); includes(created) != 1 {
+		t.Errorf("kitty.conf nuevo:\n%s", created)
+	}
+}
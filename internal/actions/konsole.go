@@ -0,0 +1,98 @@
+// Package: actions
+// Acciones de configuración de Konsole (perfil y esquema de colores XEBEC)
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// GetKonsoleDataDir retorna el directorio de perfiles y esquemas de Konsole
+func GetKonsoleDataDir() string {
+	return filepath.Join(xdgDataHome(), "konsole")
+}
+
+// GetKonsoleProfilePath retorna la ruta de XEBEC.profile
+func GetKonsoleProfilePath() string {
+	return filepath.Join(GetKonsoleDataDir(), "XEBEC.profile")
+}
+
+// GetKonsoleColorSchemePath retorna la ruta de XEBEC.colorscheme
+func GetKonsoleColorSchemePath() string {
+	return filepath.Join(GetKonsoleDataDir(), "XEBEC.colorscheme")
+}
+
+// GetKonsoleSourceDir retorna el directorio con las plantillas de Konsole
+func GetKonsoleSourceDir() string {
+	_, currentFile, _, _ := runtime.Caller(0)
+	projectRoot := filepath.Dir(filepath.Dir(filepath.Dir(currentFile)))
+	return filepath.Join(projectRoot, "konsole")
+}
+
+// RenderKonsoleFiles genera XEBEC.profile y, con colores, XEBEC.colorscheme
+// Retorna un mapa ruta -> contenido
+func RenderKonsoleFiles(sourceDir string, data ProfileTemplateData) (map[string]string, error) {
+	files := map[string]string{"XEBEC.profile": GetKonsoleProfilePath()}
+	if data.Modules["colors"] {
+		files["XEBEC.colorscheme"] = GetKonsoleColorSchemePath()
+	}
+
+	rendered := make(map[string]string, len(files))
+	for name, path := range files {
+		tmpl, err := os.ReadFile(filepath.Join(sourceDir, name))
+		if err != nil {
+			return nil, fmt.Errorf("error leyendo plantilla %s: %w", name, err)
+		}
+		content, err := renderTemplate(name, string(tmpl), data)
+		if err != nil {
+			return nil, fmt.Errorf("error generando configuración: %w", err)
+		}
+		rendered[path] = content
+	}
+	return rendered, nil
+}
+
+// ConfigureKonsole genera el perfil XEBEC en ~/.local/share/konsole
+func ConfigureKonsole(opts TerminalProfileOptions) (err error) {
+	defer recordConfigured("konsole", opts.modules(), &err)
+
+	data := NewProfileTemplateData(opts)
+	if opts.Shell && data.Command == "" {
+		fmt.Println("⚠ Nushell no está instalado; el perfil usará el shell de login")
+	}
+
+	files, err := RenderKonsoleFiles(GetKonsoleSourceDir(), data)
+	if err != nil {
+		return err
+	}
+	for _, path := range []string{GetKonsoleColorSchemePath(), GetKonsoleProfilePath()} {
+		if content, ok := files[path]; ok {
+			if err := writeFragment(path, content); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// IsKonsoleInstalled verifica si Konsole está instalado
+func IsKonsoleInstalled() bool {
+	return isAnyBinaryInstalled([]string{"konsole"})
+}
+
+// GetKonsoleStatus retorna el estado actual de Konsole
+func GetKonsoleStatus() (installed bool, configured bool, configPath string) {
+	configPath = GetKonsoleProfilePath()
+	installed = IsKonsoleInstalled()
+
+	if _, err := os.Stat(configPath); err == nil {
+		configured = true
+	}
+
+	return
+}
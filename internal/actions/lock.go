@@ -0,0 +1,376 @@
+// Package: actions
+// xebec.lock: versiones de herramientas, hashes de plantillas y del tema para reproducir un perfil
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// LockName nombre del lockfile, junto al manifiesto
+const LockName = "xebec.lock"
+
+// LockVersion versión del formato del lockfile
+const LockVersion = 1
+
+// Lock estado exacto con el que se aplicó un manifiesto
+type Lock struct {
+	Version   int                            `json:"version"`
+	Xebec     string                         `json:"xebec"`               // Versión del CLI que generó el lock
+	Theme     *LockTheme                     `json:"theme,omitempty"`     // Tema del manifiesto
+	Font      *LockFont                      `json:"font,omitempty"`      // Fuente, si el manifiesto la instala
+	Tools     map[string]map[string]LockTool `json:"tools,omitempty"`     // Sistema -> herramienta
+	Templates map[string]LockTemplate        `json:"templates,omitempty"` // Configurador -> plantilla
+
+	notInstalled map[string]bool // Configuradores del manifiesto que no están instalados aquí (BuildLock)
+}
+
+// LockTheme tema y hash de cada variante (la apariencia auto depende de la máquina)
+type LockTheme struct {
+	Name       string            `json:"name"`
+	Appearance string            `json:"appearance"`
+	Hashes     map[string]string `json:"hashes"` // dark/light -> sha256 del tema resuelto
+}
+
+// LockFont fuente instalada por xebec
+type LockFont struct {
+	Family  string `json:"family"`
+	Version string `json:"version"`
+}
+
+// LockTool versión y origen de una herramienta
+type LockTool struct {
+	Version string `json:"version"`
+	Source  string `json:"source"`            // Gestor de paquetes (apt, brew, winget...) o "path"
+	Package string `json:"package,omitempty"` // Nombre del paquete en ese gestor
+}
+
+// LockTemplate secciones aplicadas y hash de la plantilla de un configurador
+type LockTemplate struct {
+	Sections []string `json:"sections"`
+	Hash     string   `json:"hash,omitempty"` // Vacío si la configuración se genera en Go (PowerShell, Windows Terminal)
+}
+
+// LockMismatch componente que no coincide con el lock
+type LockMismatch struct {
+	Component string
+	Locked    string
+	Current   string
+}
+
+// String describe la diferencia
+func (m LockMismatch) String() string {
+	return fmt.Sprintf("%s: lock %s, aquí %s", m.Component, m.Locked, m.Current)
+}
+
+// GetLockPath retorna la ruta del lock de un manifiesto
+func GetLockPath(manifestPath string) string {
+	return filepath.Join(filepath.Dir(manifestPath), LockName)
+}
+
+// LoadLock lee un lockfile; ok es false si no existe
+func LoadLock(path string) (Lock, bool, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return Lock{}, false, nil
+	}
+	if err != nil {
+		return Lock{}, false, fmt.Errorf("error leyendo %s: %w", path, err)
+	}
+	var lock Lock
+	if err := json.Unmarshal(data, &lock); err != nil {
+		return Lock{}, false, fmt.Errorf("error parseando %s: %w", path, err)
+	}
+	if lock.Version > LockVersion {
+		return Lock{}, false, fmt.Errorf("%s usa la versión %d del lock; esta versión de xebec solo admite hasta la %d", path, lock.Version, LockVersion)
+	}
+	return lock, true, nil
+}
+
+// SaveLock escribe el lockfile (claves ordenadas para que los diffs sean estables)
+func SaveLock(path string, lock Lock) error {
+	data, err := json.MarshalIndent(lock, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error generando %s: %w", LockName, err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("error escribiendo %s: %w", path, err)
+	}
+	return nil
+}
+
+// BuildLock toma una instantánea del perfil en esta máquina
+// Tema y plantillas salen del manifiesto; las herramientas, de lo instalado
+func BuildLock(p ManifestProfile, xebecVersion string) (Lock, error) {
+	lock := Lock{Version: LockVersion, Xebec: xebecVersion}
+
+	if p.Theme != "" || p.Appearance != "" {
+		state, err := LoadState()
+		if err != nil {
+			return Lock{}, err
+		}
+		theme := &LockTheme{Name: state.Theme, Appearance: state.Appearance, Hashes: map[string]string{}}
+		if p.Theme != "" {
+			theme.Name = themeFamily(ThemeSlug(p.Theme))
+		}
+		if p.Appearance != "" {
+			theme.Appearance = p.Appearance
+		}
+		for _, variant := range []string{AppearanceDark, AppearanceLight} {
+			resolved, err := ResolveTheme(theme.Name, variant)
+			if err != nil {
+				return Lock{}, err
+			}
+			data, err := json.Marshal(resolved)
+			if err != nil {
+				return Lock{}, err
+			}
+			theme.Hashes[variant] = hashBytes(data)
+		}
+		lock.Theme = theme
+	}
+
+	if p.Font != nil && *p.Font {
+		lock.Font = &LockFont{Family: XebecFont.Family, Version: XebecFont.Version}
+	}
+
+	tools := map[string]LockTool{}
+	for _, id := range p.Tools {
+		tool, ok := FindTool(id)
+		if !ok || !tool.IsInstalled() {
+			continue
+		}
+		source, pkg := tool.Source()
+		tools[tool.ID] = LockTool{Version: tool.Version(), Source: source, Package: pkg}
+	}
+	if len(tools) > 0 {
+		lock.Tools = map[string]map[string]LockTool{runtime.GOOS: tools}
+	}
+
+	for _, c := range Configurators {
+		sections, ok := p.Terminals[c.ID]
+		if !ok {
+			sections, ok = p.Shells[c.ID]
+		}
+		if !ok || !sections.Enabled {
+			continue
+		}
+		ids, err := c.ResolveSections(sections.IDs)
+		if err != nil {
+			return Lock{}, err
+		}
+		// Como las herramientas: solo lo que está instalado en esta máquina
+		if installed, _, _ := c.Status(); !installed {
+			if lock.notInstalled == nil {
+				lock.notInstalled = map[string]bool{}
+			}
+			lock.notInstalled[c.ID] = true
+			continue
+		}
+		sort.Strings(ids)
+		hash, err := TemplateHash(c)
+		if err != nil {
+			return Lock{}, err
+		}
+		if lock.Templates == nil {
+			lock.Templates = map[string]LockTemplate{}
+		}
+		lock.Templates[c.ID] = LockTemplate{Sections: ids, Hash: hash}
+	}
+	return lock, nil
+}
+
+// TemplateHash retorna el sha256 de la plantilla (o del directorio de plantillas) de un configurador
+func TemplateHash(c Configurator) (string, error) {
+	if c.Source == nil {
+		return "", nil
+	}
+	root := c.Source()
+	info, err := os.Stat(root)
+	if err != nil {
+		return "", fmt.Errorf("error leyendo plantilla de %s: %w", c.Name, err)
+	}
+	if !info.IsDir() {
+		data, err := os.ReadFile(root)
+		if err != nil {
+			return "", fmt.Errorf("error leyendo plantilla de %s: %w", c.Name, err)
+		}
+		return hashBytes(data), nil
+	}
+
+	// Directorio: nombre relativo y contenido de cada archivo, en orden
+	h := sha256.New()
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, _ := filepath.Rel(root, path)
+		fmt.Fprintf(h, "%s\x00%d\x00", filepath.ToSlash(rel), len(data))
+		h.Write(data)
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("error leyendo plantillas de %s: %w", c.Name, err)
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashBytes retorna "sha256:<hex>" del contenido
+func hashBytes(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// VerifyLock compara el lock con la instantánea actual de esta máquina
+// Las herramientas del lock que faltan aquí se informan, igual que las plantillas de configuradores
+// que el manifiesto pide pero no están instalados; los componentes nuevos se añaden con MergeLock
+func VerifyLock(locked, current Lock) []LockMismatch {
+	var out []LockMismatch
+	if locked.Xebec != "" && locked.Xebec != current.Xebec {
+		out = append(out, LockMismatch{"xebec", locked.Xebec, current.Xebec})
+	}
+
+	if locked.Theme != nil && current.Theme != nil {
+		l, c := locked.Theme, current.Theme
+		if l.Name != c.Name || l.Appearance != c.Appearance {
+			out = append(out, LockMismatch{"tema", l.Name + " (" + l.Appearance + ")", c.Name + " (" + c.Appearance + ")"})
+		} else {
+			for _, variant := range []string{AppearanceDark, AppearanceLight} {
+				if l.Hashes[variant] != c.Hashes[variant] {
+					out = append(out, LockMismatch{"tema " + l.Name + " " + variant, shortHash(l.Hashes[variant]), shortHash(c.Hashes[variant])})
+				}
+			}
+		}
+	}
+
+	if locked.Font != nil && current.Font != nil && locked.Font.Version != current.Font.Version {
+		out = append(out, LockMismatch{locked.Font.Family, locked.Font.Version, current.Font.Version})
+	}
+
+	lockedTools, currentTools := locked.Tools[runtime.GOOS], current.Tools[runtime.GOOS]
+	for _, id := range sortedKeys(lockedTools) {
+		l := lockedTools[id]
+		c, ok := currentTools[id]
+		if !ok {
+			out = append(out, LockMismatch{id, l.Version, "no instalado"})
+			continue
+		}
+		if l.Version != c.Version {
+			out = append(out, LockMismatch{id, l.Version, c.Version})
+		}
+		if l.Source != c.Source {
+			out = append(out, LockMismatch{id + " (origen)", l.Source, c.Source})
+		}
+	}
+
+	for _, id := range sortedKeys(locked.Templates) {
+		l := locked.Templates[id]
+		c, ok := current.Templates[id]
+		if !ok {
+			// Sin pedir en este sistema (os.<sistema> del manifiesto) no es una diferencia
+			if current.notInstalled[id] {
+				out = append(out, LockMismatch{id + " (plantilla)", shortHash(l.Hash), "no instalado"})
+			}
+			continue
+		}
+		if strings.Join(l.Sections, ",") != strings.Join(c.Sections, ",") {
+			out = append(out, LockMismatch{id + " (secciones)", strings.Join(l.Sections, ", "), strings.Join(c.Sections, ", ")})
+		}
+		if l.Hash != c.Hash {
+			out = append(out, LockMismatch{id + " (plantilla)", shortHash(l.Hash), shortHash(c.Hash)})
+		}
+	}
+	return out
+}
+
+// MergeLock añade al lock los componentes que aún no tiene
+// Con update, lo de esta máquina reemplaza al lock (las herramientas de otros sistemas se conservan)
+func MergeLock(locked, current Lock, update bool) Lock {
+	merged := locked
+	merged.Version = LockVersion
+	if update || merged.Xebec == "" {
+		merged.Xebec = current.Xebec
+	}
+	if update || merged.Theme == nil {
+		merged.Theme = current.Theme
+	}
+	if update || merged.Font == nil {
+		merged.Font = current.Font
+	}
+
+	tools := map[string]map[string]LockTool{}
+	for goos, byTool := range locked.Tools {
+		if goos == runtime.GOOS && update {
+			continue
+		}
+		tools[goos] = byTool
+	}
+	for id, t := range current.Tools[runtime.GOOS] {
+		if tools[runtime.GOOS] == nil {
+			tools[runtime.GOOS] = map[string]LockTool{}
+		}
+		if _, ok := tools[runtime.GOOS][id]; !ok || update {
+			tools[runtime.GOOS][id] = t
+		}
+	}
+	merged.Tools = nil
+	if len(tools) > 0 {
+		merged.Tools = tools
+	}
+
+	// Con update se conservan las plantillas de configuradores que no están instalados aquí
+	// (Windows Terminal en Linux): las fijó otra máquina
+	templates := map[string]LockTemplate{}
+	for id, t := range locked.Templates {
+		if !update || current.notInstalled[id] {
+			templates[id] = t
+		}
+	}
+	for id, t := range current.Templates {
+		if _, ok := templates[id]; !ok {
+			templates[id] = t
+		}
+	}
+	merged.Templates = nil
+	if len(templates) > 0 {
+		merged.Templates = templates
+	}
+	return merged
+}
+
+// shortHash acorta un hash para mostrarlo
+func shortHash(hash string) string {
+	hash = strings.TrimPrefix(hash, "sha256:")
+	if hash == "" {
+		return "-"
+	}
+	if len(hash) > 12 {
+		return hash[:12]
+	}
+	return hash
+}
+
+// sortedKeys retorna las claves de un mapa ordenadas
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,96 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestVerifyLockAbsent(t *testing.T) {
+	locked := Lock{
+		Xebec: "1.0.0",
+		Tools: map[string]map[string]LockTool{runtime.GOOS: {
+			"fzf": {Version: "0.44.1", Source: "apt"},
+			"bat": {Version: "0.24.0", Source: "apt"},
+		}},
+		Templates: map[string]LockTemplate{
+			"kitty":            {Sections: []string{"colors"}, Hash: "sha256:aaaaaaaaaaaaaaaa"},
+			"windows_terminal": {Sections: []string{"colors"}},
+			"foot":             {Sections: []string{"colors"}, Hash: "sha256:bbbbbbbbbbbbbbbb"},
+		},
+	}
+	current := Lock{
+		Xebec:        "1.0.0",
+		Tools:        map[string]map[string]LockTool{runtime.GOOS: {"fzf": {Version: "0.44.1", Source: "apt"}}},
+		Templates:    map[string]LockTemplate{"foot": {Sections: []string{"colors"}, Hash: "sha256:bbbbbbbbbbbbbbbb"}},
+		notInstalled: map[string]bool{"kitty": true},
+	}
+
+	// windows_terminal no se pide en este sistema: no es una diferencia
+	want := []LockMismatch{
+		{"bat", "0.24.0", "no instalado"},
+		{"kitty (plantilla)", "aaaaaaaaaaaa", "no instalado"},
+	}
+	if got := VerifyLock(locked, current); !reflect.DeepEqual(got, want) {
+		t.Errorf("VerifyLock() = %v, want %v", got, want)
+	}
+
+	// --update-lock conserva la plantilla de kitty, que fijó otra máquina
+	merged := MergeLock(locked, current, true)
+	if _, ok := merged.Templates["kitty"]; !ok {
+		t.Error("MergeLock con update descartó la plantilla de kitty")
+	}
+	if _, ok := merged.Templates["windows_terminal"]; ok {
+		t.Error("MergeLock con update conservó windows_terminal")
+	}
+}
+
+func TestBuildPlanLockedVersions(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("usa dnf como gestor de paquetes")
+	}
+	setupPlanHome(t)
+	bin := fakeBinaries(t)
+	log := filepath.Join(t.TempDir(), "dnf.log")
+	fakeScript(t, bin, "dnf", `echo "$@" > `+log+"\n")
+	fakeScript(t, bin, "sudo", `exec "$@"`+"\n")
+	fakeScript(t, bin, "bat", "echo bat 0.23.0\n")
+
+	locked := Lock{Tools: map[string]map[string]LockTool{runtime.GOOS: {
+		"fzf": {Version: "0.44.1", Source: "dnf"},
+		"bat": {Version: "0.24.0", Source: "dnf"},
+	}}}
+
+	// bat instalado con otra versión: el plan no se construye
+	_, err := BuildPlan(ManifestProfile{Tools: []string{"fzf", "bat"}}, locked)
+	if err == nil || !strings.Contains(err.Error(), "bat 0.23.0") || !strings.Contains(err.Error(), "0.24.0") {
+		t.Errorf("BuildPlan con bat 0.23.0 err = %v, want la versión del lock", err)
+	}
+
+	// fzf falta: se instala con la versión del lock
+	plan, err := BuildPlan(ManifestProfile{Tools: []string{"fzf"}}, locked)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Steps) != 1 || !strings.Contains(plan.Steps[0].Detail, "0.44.1") {
+		t.Fatalf("plan = %+v, want instalar fzf 0.44.1", plan.Steps)
+	}
+	if err := ApplyPlan(plan); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimSpace(string(data)); got != "install -y fzf-0.44.1" {
+		t.Errorf("dnf %s, want install -y fzf-0.44.1", got)
+	}
+
+	// Sin lock no se fija nada
+	if _, err := BuildPlan(ManifestProfile{Tools: []string{"bat"}}, Lock{}); err != nil {
+		t.Errorf("BuildPlan sin lock: %v", err)
+	}
+}
@@ -0,0 +1,435 @@
+// Package: actions
+// Manifiesto de perfil (xebec.yaml): plan y aplicación declarativa de terminales, shells, herramientas y tema
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// ManifestVersion versión del formato del manifiesto
+const ManifestVersion = 1
+
+// ManifestNames nombres que se buscan en el directorio actual si no se indica -f
+var ManifestNames = []string{"xebec.yaml", "xebec.yml", "xebec.toml", "xebec.json"}
+
+// Sections secciones de una herramienta en el manifiesto
+// Acepta true o "all" (todas), false (excluir), una lista o "a,b"
+type Sections struct {
+	Enabled bool
+	IDs     []string // Vacío: las secciones por defecto
+}
+
+// UnmarshalJSON admite los distintos formatos de Sections
+func (s *Sections) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	switch v := raw.(type) {
+	case nil:
+		*s = Sections{Enabled: true}
+	case bool:
+		*s = Sections{Enabled: v}
+	case string:
+		*s = Sections{Enabled: true}
+		if v != "all" {
+			s.IDs = splitList(v)
+		}
+	case []interface{}:
+		*s = Sections{Enabled: true}
+		for _, item := range v {
+			id, ok := item.(string)
+			if !ok {
+				return fmt.Errorf("sección no válida: %v", item)
+			}
+			s.IDs = append(s.IDs, id)
+		}
+	default:
+		return fmt.Errorf("secciones no válidas: %v (usa true, \"all\" o una lista)", v)
+	}
+	return nil
+}
+
+// MarshalJSON escribe las secciones en su forma más corta
+func (s Sections) MarshalJSON() ([]byte, error) {
+	if !s.Enabled {
+		return []byte("false"), nil
+	}
+	if len(s.IDs) == 0 {
+		return []byte(`"all"`), nil
+	}
+	return json.Marshal(s.IDs)
+}
+
+// ManifestProfile lo que se aplica en una máquina
+type ManifestProfile struct {
+	Theme      string              `json:"theme,omitempty"`
+	Appearance string              `json:"appearance,omitempty"`
+	Font       *bool               `json:"font,omitempty"`      // Instalar JetBrains Mono
+	Terminals  map[string]Sections `json:"terminals,omitempty"` // Configurador -> secciones
+	Shells     map[string]Sections `json:"shells,omitempty"`    // Shells y Starship
+	Tools      []string            `json:"tools,omitempty"`     // fzf, zoxide, bat, delta, eza
+}
+
+// Manifest manifiesto compartido; OS sobrescribe el perfil por sistema (windows, darwin, linux)
+type Manifest struct {
+	Version int `json:"version,omitempty"`
+	ManifestProfile
+	OS map[string]ManifestProfile `json:"os,omitempty"`
+}
+
+// FindManifest busca un manifiesto en dir con los nombres de ManifestNames
+func FindManifest(dir string) (string, error) {
+	for _, name := range ManifestNames {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("no se encontró %s en %s (usa -f)", strings.Join(ManifestNames, ", "), dir)
+}
+
+// LoadManifest lee un manifiesto YAML, TOML o JSON según la extensión
+func LoadManifest(path string) (Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("error leyendo manifiesto: %w", err)
+	}
+
+	var tree map[string]interface{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		tree, err = parseManifestYAML(data)
+	case ".toml":
+		tree, err = ParseTOML(string(data))
+	case ".json":
+		err = json.Unmarshal(StripJSONC(data), &tree)
+	default:
+		return Manifest{}, fmt.Errorf("formato de manifiesto desconocido: %s (usa .yaml, .toml o .json)", filepath.Base(path))
+	}
+	if err != nil {
+		return Manifest{}, fmt.Errorf("error parseando %s: %w", path, err)
+	}
+
+	var m Manifest
+	if err := decodeManifestTree(tree, &m); err != nil {
+		return Manifest{}, fmt.Errorf("error en %s: %w", path, err)
+	}
+	if m.Version > ManifestVersion {
+		return Manifest{}, fmt.Errorf("%s usa la versión %d del manifiesto; esta versión de xebec solo admite hasta la %d", path, m.Version, ManifestVersion)
+	}
+	return m, nil
+}
+
+// Profile retorna el perfil para un sistema aplicando su bloque os.<goos>
+// Los valores simples se sustituyen, las herramientas de terminals/shells se combinan
+// (false excluye una del perfil base) y tools se añade a la lista base
+func (m Manifest) Profile(goos string) ManifestProfile {
+	p := m.ManifestProfile
+	p.Terminals = mergeSections(p.Terminals, nil)
+	p.Shells = mergeSections(p.Shells, nil)
+	p.Tools = append([]string(nil), p.Tools...)
+
+	o, ok := m.OS[goos]
+	if !ok {
+		return p
+	}
+	if o.Theme != "" {
+		p.Theme = o.Theme
+	}
+	if o.Appearance != "" {
+		p.Appearance = o.Appearance
+	}
+	if o.Font != nil {
+		p.Font = o.Font
+	}
+	p.Terminals = mergeSections(p.Terminals, o.Terminals)
+	p.Shells = mergeSections(p.Shells, o.Shells)
+	for _, tool := range o.Tools {
+		if !containsID(p.Tools, tool) {
+			p.Tools = append(p.Tools, tool)
+		}
+	}
+	return p
+}
+
+// mergeSections copia base y sobrescribe con override
+func mergeSections(base, override map[string]Sections) map[string]Sections {
+	out := make(map[string]Sections, len(base)+len(override))
+	for k, v := range base {
+		out[k] = v
+	}
+	for k, v := range override {
+		out[k] = v
+	}
+	return out
+}
+
+// Acciones de un paso del plan
+const (
+	PlanNoop      = "noop"      // Ya coincide con el manifiesto
+	PlanInstall   = "install"   // Instalar herramienta o fuente
+	PlanConfigure = "configure" // Configurar o reconfigurar una herramienta
+	PlanTheme     = "theme"     // Cambiar tema o apariencia
+	PlanSkip      = "skip"      // No se puede aplicar en esta máquina
+)
+
+// PlanStep cambio (o no) que el manifiesto pide para un componente
+type PlanStep struct {
+	Kind   string // theme, font, tool, terminal o shell
+	ID     string
+	Name   string
+	Action string
+	Detail string
+	run    func() error
+}
+
+// Plan pasos en el orden en que se aplican
+type Plan struct {
+	Steps []PlanStep
+}
+
+// Count retorna cuántos pasos tienen la acción indicada
+func (p Plan) Count(action string) int {
+	n := 0
+	for _, s := range p.Steps {
+		if s.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// HasChanges indica si aplicar el plan cambiaría algo
+func (p Plan) HasChanges() bool {
+	for _, s := range p.Steps {
+		if s.Action != PlanNoop && s.Action != PlanSkip {
+			return true
+		}
+	}
+	return false
+}
+
+// BuildPlan compara el perfil con la máquina actual
+// Los errores del manifiesto (IDs o secciones desconocidas) se acumulan y no se construye el plan
+// Las herramientas se instalan con la versión de locked; una ya instalada con otra versión es un error
+func BuildPlan(p ManifestProfile, locked Lock) (Plan, error) {
+	state, err := LoadState()
+	if err != nil {
+		return Plan{}, err
+	}
+
+	// Archivos editados o borrados a mano: la herramienta se reconfigura aunque las secciones coincidan
+	drifts, err := DetectDrift()
+	if err != nil {
+		return Plan{}, err
+	}
+	drifted := map[string]FileDrift{}
+	for _, d := range drifts {
+		if d.Status == DriftUserModified || d.Status == DriftMissing {
+			if _, seen := drifted[d.Tool]; !seen {
+				drifted[d.Tool] = d
+			}
+		}
+	}
+
+	var plan Plan
+	var errs []error
+
+	// Herramientas que el plan reconfigura: el cambio de tema no las regenera antes (evita aplicar dos veces)
+	configure := map[string]bool{}
+	if step, ok, err := planTheme(p, state, configure); err != nil {
+		errs = append(errs, err)
+	} else if ok {
+		plan.Steps = append(plan.Steps, step)
+	}
+
+	if p.Font != nil && *p.Font {
+		plan.Steps = append(plan.Steps, planFont())
+	}
+
+	for _, id := range p.Tools {
+		tool, ok := FindTool(id)
+		if !ok {
+			errs = append(errs, fmt.Errorf("tools: herramienta desconocida %q", id))
+			continue
+		}
+		step := PlanStep{Kind: "tool", ID: tool.ID, Name: tool.Name, Action: PlanNoop, Detail: "instalado"}
+		version := locked.Tools[runtime.GOOS][tool.ID].Version
+		if !tool.IsInstalled() {
+			step.Action, step.Detail = PlanInstall, "no instalado"
+			if version != "" {
+				step.Detail += fmt.Sprintf(" (%s %s)", LockName, version)
+			}
+			step.run = func() error { return InstallToolVersion(tool, version) }
+		} else if current := tool.Version(); version != "" && current != version {
+			errs = append(errs, fmt.Errorf("tools: %s %s instalado, %s fija %s (xebec apply --update-lock acepta la instalada)", tool.ID, current, LockName, version))
+			continue
+		}
+		plan.Steps = append(plan.Steps, step)
+	}
+
+	errs = append(errs, checkManifestTools("terminals", "terminal_", p.Terminals)...)
+	errs = append(errs, checkManifestTools("shells", "shell_", p.Shells)...)
+	for _, c := range Configurators {
+		kind, sections, ok := "terminal", Sections{}, false
+		if sections, ok = p.Terminals[c.ID]; !ok {
+			kind = "shell"
+			sections, ok = p.Shells[c.ID]
+		}
+		if !ok || !sections.Enabled {
+			continue
+		}
+		step, err := planConfigurator(c, kind, sections, state, drifted)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		plan.Steps = append(plan.Steps, step)
+	}
+
+	if len(errs) > 0 {
+		return Plan{}, errors.Join(errs...)
+	}
+	for _, step := range plan.Steps {
+		if step.Action == PlanConfigure {
+			configure[step.ID] = true
+		}
+	}
+	return plan, nil
+}
+
+// planTheme compara tema y apariencia con el estado
+// El paso va antes que los configuradores y no regenera los de skip, que se configuran después con el tema nuevo
+func planTheme(p ManifestProfile, state XebecState, skip map[string]bool) (PlanStep, bool, error) {
+	if p.Theme == "" && p.Appearance == "" {
+		return PlanStep{}, false, nil
+	}
+	name, appearance := state.Theme, state.Appearance
+	if p.Theme != "" {
+		name = themeFamily(ThemeSlug(p.Theme))
+	}
+	if p.Appearance != "" {
+		appearance = p.Appearance
+	}
+	if !containsID(Appearances, appearance) {
+		return PlanStep{}, false, fmt.Errorf("appearance: %q no es válida (disponibles: %s)", appearance, strings.Join(Appearances, ", "))
+	}
+	if _, err := ResolveTheme(name, appearance); err != nil {
+		return PlanStep{}, false, fmt.Errorf("theme: %w", err)
+	}
+
+	step := PlanStep{Kind: "theme", ID: name, Name: "Tema", Action: PlanNoop, Detail: fmt.Sprintf("%s (%s)", name, appearance)}
+	if name != state.Theme || appearance != state.Appearance {
+		step.Action = PlanTheme
+		step.Detail = fmt.Sprintf("%s (%s) → %s (%s)", state.Theme, state.Appearance, name, appearance)
+		step.run = func() error {
+			_, err := switchTheme(name, appearance, skip)
+			return err
+		}
+	}
+	return step, true, nil
+}
+
+// planFont comprueba JetBrains Mono
+func planFont() PlanStep {
+	step := PlanStep{Kind: "font", ID: "font", Name: XebecFont.Family, Action: PlanNoop, Detail: "instalada"}
+	if !IsXebecFontInstalled() {
+		step.Action, step.Detail = PlanInstall, XebecFont.Version
+		step.run = func() error {
+			_, err := InstallFont(XebecFont, "")
+			return err
+		}
+	}
+	return step
+}
+
+// planConfigurator compara las secciones pedidas con las aplicadas por última vez
+// y con drifted (archivos de la herramienta editados o borrados desde que XEBEC los escribió)
+func planConfigurator(c Configurator, kind string, sections Sections, state XebecState, drifted map[string]FileDrift) (PlanStep, error) {
+	ids, err := c.ResolveSections(sections.IDs)
+	if err != nil {
+		return PlanStep{}, err
+	}
+	sorted := append([]string(nil), ids...)
+	sort.Strings(sorted)
+
+	step := PlanStep{Kind: kind, ID: c.ID, Name: c.Name, Action: PlanNoop, Detail: strings.Join(sorted, ", ")}
+	installed, configured, _ := c.Status()
+	switch {
+	case !installed:
+		step.Action, step.Detail = PlanSkip, "no instalado"
+	case configured && strings.Join(state.Configured[c.ID], ",") == strings.Join(sorted, ","):
+		// Coincide con lo último aplicado, salvo que se haya tocado el archivo
+		if d, ok := drifted[c.ID]; ok {
+			step.Action = PlanConfigure
+			step.Detail = fmt.Sprintf("%s (%s: %s)", step.Detail, d.Path, d.Status)
+			step.run = func() error { return c.Apply(ids) }
+		}
+	default:
+		step.Action = PlanConfigure
+		if previous := state.Configured[c.ID]; len(previous) > 0 {
+			step.Detail = fmt.Sprintf("%s → %s", strings.Join(previous, ", "), step.Detail)
+		}
+		step.run = func() error { return c.Apply(ids) }
+	}
+	return step, nil
+}
+
+// checkManifestTools valida que los IDs de terminals/shells existan y estén en su lista
+func checkManifestTools(field, prefix string, tools map[string]Sections) []error {
+	var errs []error
+	ids := make([]string, 0, len(tools))
+	for id := range tools {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		c, ok := FindConfigurator(id)
+		switch {
+		case !ok:
+			errs = append(errs, fmt.Errorf("%s: herramienta desconocida %q", field, id))
+		case c.ID != id:
+			errs = append(errs, fmt.Errorf("%s: usa %q en lugar de %q", field, c.ID, id))
+		case !strings.HasPrefix(c.MenuID, prefix):
+			errs = append(errs, fmt.Errorf("%s: %s no va en %s", field, c.ID, field))
+		}
+	}
+	return errs
+}
+
+// ApplyPlan ejecuta los pasos con cambios; los errores se acumulan sin detener el resto
+func ApplyPlan(plan Plan) error {
+	var errs []error
+	for _, step := range plan.Steps {
+		if step.run == nil {
+			continue
+		}
+		fmt.Printf("→ %s\n", step.Name)
+		if err := step.run(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", step.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// splitList separa "a, b,c" en sus elementos
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,205 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// fakeConfigurators sustituye los configuradores por dos falsos que cuentan sus Apply
+func fakeConfigurators(t *testing.T, applied map[string]int) {
+	t.Helper()
+	options := func() []ConfigOption { return []ConfigOption{{ID: "colors"}, {ID: "font"}} }
+	fake := func(id, menuID string) Configurator {
+		return Configurator{
+			ID: id, MenuID: menuID, Name: id, Options: options,
+			Status: func() (bool, bool, string) { return true, true, "" },
+			Apply:  func([]string) error { applied[id]++; return nil },
+		}
+	}
+	saved := Configurators
+	Configurators = []Configurator{fake("alacritty", "terminal_alacritty"), fake("kitty", "terminal_kitty")}
+	t.Cleanup(func() {
+		Configurators = saved
+		SetActiveTheme(DefaultTheme())
+	})
+}
+
+func setupPlanHome(t *testing.T) {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, ".config"))
+	t.Setenv("XDG_DATA_HOME", filepath.Join(home, ".local", "share"))
+	// Escrituras que otros tests dejaron sin volcar al historial
+	pendingWrites = nil
+}
+
+// fakeBinaries deja en PATH solo ejecutables vacíos con esos nombres y retorna su directorio
+func fakeBinaries(t *testing.T, names ...string) string {
+	t.Helper()
+	bin := t.TempDir()
+	for _, name := range names {
+		fakeScript(t, bin, name, "")
+	}
+	t.Setenv("PATH", bin)
+	return bin
+}
+
+// fakeScript crea en bin un ejecutable sh con ese cuerpo (solo builtins: PATH no tiene /bin)
+func fakeScript(t *testing.T, bin, name, body string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(bin, name), []byte("#!/bin/sh\n"+body), 0755); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestApplyPlanThemeChangeAppliesOnce(t *testing.T) {
+	setupPlanHome(t)
+	applied := map[string]int{}
+	fakeConfigurators(t, applied)
+	if err := SaveState(XebecState{Theme: "xebec", Appearance: AppearanceDark, Configured: map[string][]string{
+		"alacritty": {"colors"},
+		"kitty":     {"colors"},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := BuildPlan(ManifestProfile{
+		Appearance: AppearanceLight,
+		Terminals: map[string]Sections{
+			"alacritty": {Enabled: true, IDs: []string{"colors"}},
+			"kitty":     {Enabled: true, IDs: []string{"colors", "font"}},
+		},
+	}, Lock{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ApplyPlan(plan); err != nil {
+		t.Fatal(err)
+	}
+
+	// alacritty solo cambia de tema y kitty se reconfigura una vez, ya con el tema nuevo
+	if applied["alacritty"] != 1 || applied["kitty"] != 1 {
+		t.Errorf("Apply por herramienta = %v, want alacritty:1 kitty:1", applied)
+	}
+}
+
+func TestBuildPlanDrift(t *testing.T) {
+	setupPlanHome(t)
+	applied := map[string]int{}
+	fakeConfigurators(t, applied)
+	if err := SaveState(XebecState{Theme: "xebec", Appearance: AppearanceDark, Configured: map[string][]string{
+		"alacritty": {"colors"},
+		"kitty":     {"colors"},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	alacritty, kitty := filepath.Join(dir, "alacritty.toml"), filepath.Join(dir, "kitty.conf")
+	for path, tool := range map[string]string{alacritty: "alacritty", kitty: "kitty"} {
+		if err := os.WriteFile(path, []byte("# xebec\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		trackWrite(path, "# xebec\n")
+		flushHistory(tool)
+	}
+	if err := os.WriteFile(kitty, []byte("# editado\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	profile := ManifestProfile{Terminals: map[string]Sections{
+		"alacritty": {Enabled: true, IDs: []string{"colors"}},
+		"kitty":     {Enabled: true, IDs: []string{"colors"}},
+	}}
+	actions := func() map[string]string {
+		plan, err := BuildPlan(profile, Lock{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := map[string]string{}
+		for _, step := range plan.Steps {
+			got[step.ID] = step.Action
+		}
+		return got
+	}
+
+	if got := actions(); got["alacritty"] != PlanNoop || got["kitty"] != PlanConfigure {
+		t.Errorf("kitty editado: acciones = %v", got)
+	}
+	if err := os.Remove(alacritty); err != nil {
+		t.Fatal(err)
+	}
+	if got := actions(); got["alacritty"] != PlanConfigure {
+		t.Errorf("alacritty borrado: acciones = %v", got)
+	}
+}
+
+func TestLoadManifestTOML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "xebec.toml")
+	data := `version = 1
+theme = "xebec"
+tools = [
+    "fzf",   # buscador
+    "bat",
+]
+terminals = { alacritty = ["colors", "font"] }
+
+[os.windows.terminals]
+windows_terminal = "all"
+"alacritty" = false
+`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	m, err := LoadManifest(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Version != 1 || m.Theme != "xebec" || strings.Join(m.Tools, ",") != "fzf,bat" {
+		t.Errorf("manifiesto = %+v", m)
+	}
+	if got := m.Terminals["alacritty"]; !got.Enabled || strings.Join(got.IDs, ",") != "colors,font" {
+		t.Errorf("terminals.alacritty = %+v", got)
+	}
+	windows := m.OS["windows"].Terminals
+	if !windows["windows_terminal"].Enabled || windows["alacritty"].Enabled {
+		t.Errorf("os.windows.terminals = %+v", windows)
+	}
+}
+
+func TestBuildPlanStarshipSettles(t *testing.T) {
+	setupPlanHome(t)
+	t.Cleanup(func() { SetActiveTheme(DefaultTheme()) })
+	bin := t.TempDir()
+	if err := os.WriteFile(filepath.Join(bin, "starship"), []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", bin)
+	t.Setenv("STARSHIP_CONFIG", filepath.Join(t.TempDir(), "starship.toml"))
+
+	// Sin layout, el configurador real aplica y registra layout_minimal
+	profile := ManifestProfile{Shells: map[string]Sections{
+		"starship": {Enabled: true, IDs: []string{"git", "time"}},
+	}}
+	plan, err := BuildPlan(profile, Lock{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plan.Count(PlanConfigure) != 1 {
+		t.Fatalf("primer plan = %+v, want configure", plan.Steps)
+	}
+	if err := ApplyPlan(plan); err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err = BuildPlan(profile, Lock{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plan.HasChanges() {
+		t.Errorf("tras apply el plan sigue con cambios: %+v", plan.Steps)
+	}
+}
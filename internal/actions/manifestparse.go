@@ -0,0 +1,309 @@
+// Package: actions
+// Lectura del manifiesto xebec en YAML (subconjunto: mapas, listas y escalares); el TOML usa ParseTOML
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// manifestLine línea útil de un archivo: número, sangría y contenido sin comentario
+type manifestLine struct {
+	num    int
+	indent int
+	text   string
+}
+
+// splitManifestLines descarta líneas vacías y comentarios (# fuera de comillas)
+func splitManifestLines(data []byte) []manifestLine {
+	var lines []manifestLine
+	for i, raw := range strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n") {
+		text := strings.TrimRight(stripComment(raw), " \t")
+		trimmed := strings.TrimLeft(text, " \t")
+		if trimmed == "" {
+			continue
+		}
+		lines = append(lines, manifestLine{num: i + 1, indent: len(text) - len(trimmed), text: trimmed})
+	}
+	return lines
+}
+
+// stripComment corta la línea en el primer # que no esté entre comillas
+func stripComment(line string) string {
+	quote := byte(0)
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// parseManifestYAML convierte un YAML con mapas por sangría, listas "- x" o [x, y] y escalares
+// No admite anclas, documentos múltiples ni bloques de texto
+func parseManifestYAML(data []byte) (map[string]interface{}, error) {
+	lines := splitManifestLines(data)
+	if len(lines) > 0 && lines[0].text == "---" {
+		lines = lines[1:]
+	}
+	if len(lines) == 0 {
+		return map[string]interface{}{}, nil
+	}
+	value, rest, err := parseYAMLBlock(lines, lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("línea %d: sangría inesperada", rest[0].num)
+	}
+	root, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("línea %d: el manifiesto debe ser un mapa de claves", lines[0].num)
+	}
+	return root, nil
+}
+
+// parseYAMLBlock lee las líneas con la sangría indicada como mapa o lista
+func parseYAMLBlock(lines []manifestLine, indent int) (interface{}, []manifestLine, error) {
+	if strings.HasPrefix(lines[0].text, "- ") || lines[0].text == "-" {
+		var list []interface{}
+		for len(lines) > 0 && lines[0].indent == indent {
+			line := lines[0]
+			if !strings.HasPrefix(line.text, "- ") && line.text != "-" {
+				return nil, nil, fmt.Errorf("línea %d: se esperaba un elemento de lista", line.num)
+			}
+			item := strings.TrimSpace(strings.TrimPrefix(line.text, "-"))
+			if item == "" || strings.HasSuffix(item, ":") || strings.Contains(item, ": ") {
+				return nil, nil, fmt.Errorf("línea %d: solo se admiten listas de valores simples", line.num)
+			}
+			value, err := parseFlowValue(item)
+			if err != nil {
+				return nil, nil, fmt.Errorf("línea %d: %w", line.num, err)
+			}
+			list = append(list, value)
+			lines = lines[1:]
+		}
+		return list, lines, nil
+	}
+
+	m := map[string]interface{}{}
+	for len(lines) > 0 && lines[0].indent == indent {
+		line := lines[0]
+		key, value, ok := cutYAMLKey(line.text)
+		if !ok {
+			return nil, nil, fmt.Errorf("línea %d: se esperaba \"clave: valor\"", line.num)
+		}
+		if _, dup := m[key]; dup {
+			return nil, nil, fmt.Errorf("línea %d: clave repetida %q", line.num, key)
+		}
+		lines = lines[1:]
+
+		if value != "" {
+			parsed, err := parseFlowValue(value)
+			if err != nil {
+				return nil, nil, fmt.Errorf("línea %d: %w", line.num, err)
+			}
+			m[key] = parsed
+			continue
+		}
+		// Sin valor: el bloque hijo son las líneas siguientes con más sangría
+		// (las listas pueden ir a la misma sangría que la clave)
+		if len(lines) > 0 && (lines[0].indent > indent || (lines[0].indent == indent && strings.HasPrefix(lines[0].text, "- "))) {
+			child, rest, err := parseYAMLBlock(lines, lines[0].indent)
+			if err != nil {
+				return nil, nil, err
+			}
+			m[key], lines = child, rest
+			continue
+		}
+		m[key] = nil
+	}
+	if len(lines) > 0 && lines[0].indent > indent {
+		return nil, nil, fmt.Errorf("línea %d: sangría inesperada", lines[0].num)
+	}
+	return m, lines, nil
+}
+
+// cutYAMLKey separa "clave: valor"; la clave puede ir entre comillas
+func cutYAMLKey(text string) (string, string, bool) {
+	if text[0] == '"' || text[0] == '\'' {
+		end := strings.IndexByte(text[1:], text[0])
+		if end < 0 || !strings.HasPrefix(text[end+2:], ":") {
+			return "", "", false
+		}
+		return text[1 : end+1], strings.TrimSpace(text[end+3:]), true
+	}
+	if strings.HasSuffix(text, ":") {
+		return strings.TrimSpace(strings.TrimSuffix(text, ":")), "", true
+	}
+	key, value, ok := strings.Cut(text, ": ")
+	if !ok || strings.TrimSpace(key) == "" {
+		return "", "", false
+	}
+	return strings.TrimSpace(key), strings.TrimSpace(value), true
+}
+
+// flowClosed indica si los corchetes de un array en línea están equilibrados
+func flowClosed(value string) bool {
+	depth, quote := 0, byte(0)
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+		}
+	}
+	return depth <= 0
+}
+
+// parseFlowValue convierte un escalar o un array en línea [a, "b", 3]
+func parseFlowValue(value string) (interface{}, error) {
+	value = strings.TrimSpace(value)
+	if !strings.HasPrefix(value, "[") {
+		return parseScalar(value)
+	}
+	if !strings.HasSuffix(value, "]") || !flowClosed(value) {
+		return nil, fmt.Errorf("array sin cerrar: %s", value)
+	}
+
+	items := []interface{}{}
+	inner := strings.TrimSpace(value[1 : len(value)-1])
+	var current strings.Builder
+	quote := byte(0)
+	flush := func() error {
+		item := strings.TrimSpace(current.String())
+		current.Reset()
+		if item == "" {
+			return nil // coma final
+		}
+		if strings.HasPrefix(item, "[") {
+			return fmt.Errorf("los arrays anidados no están soportados")
+		}
+		parsed, err := parseScalar(item)
+		if err != nil {
+			return err
+		}
+		items = append(items, parsed)
+		return nil
+	}
+	for i := 0; i < len(inner); i++ {
+		c := inner[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == ',':
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		current.WriteByte(c)
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// parseScalar convierte texto, booleanos, números y null
+func parseScalar(value string) (interface{}, error) {
+	if value == "" {
+		return nil, nil
+	}
+	switch value[0] {
+	case '"':
+		s, err := strconv.Unquote(value)
+		if err != nil {
+			return nil, fmt.Errorf("texto mal formado: %s", value)
+		}
+		return s, nil
+	case '\'':
+		if len(value) < 2 || value[len(value)-1] != '\'' {
+			return nil, fmt.Errorf("texto mal formado: %s", value)
+		}
+		return value[1 : len(value)-1], nil
+	case '{':
+		return nil, fmt.Errorf("las tablas en línea no están soportadas: %s", value)
+	}
+	switch value {
+	case "true", "yes", "on":
+		return true, nil
+	case "false", "no", "off":
+		return false, nil
+	case "null", "~":
+		return nil, nil
+	}
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return n, nil
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return f, nil
+	}
+	return value, nil
+}
+
+// decodeManifestTree vuelca el árbol genérico en v rechazando claves desconocidas
+func decodeManifestTree(tree map[string]interface{}, v interface{}) error {
+	data, err := json.Marshal(tree)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(strings.NewReader(string(data)))
+	dec.DisallowUnknownFields()
+	err = dec.Decode(v)
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &typeErr):
+		return fmt.Errorf("%s: se esperaba %s", typeErr.Field, manifestTypeName(typeErr.Type.Kind()))
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		return fmt.Errorf("campo desconocido %s", strings.TrimPrefix(err.Error(), "json: unknown field "))
+	}
+	return err
+}
+
+// manifestTypeName describe un tipo de Go con el vocabulario del manifiesto
+func manifestTypeName(kind reflect.Kind) string {
+	switch kind {
+	case reflect.Slice:
+		return "una lista"
+	case reflect.Map, reflect.Struct:
+		return "un mapa"
+	case reflect.Bool:
+		return "true o false"
+	case reflect.Int, reflect.Int64, reflect.Float64:
+		return "un número"
+	default:
+		return "un texto"
+	}
+}
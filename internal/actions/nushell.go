@@ -0,0 +1,359 @@
+// Package: actions
+// Acciones de configuración de Nushell
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"text/template"
+)
+
+// Opciones de configuración de Nushell (módulos de config.nu / env.nu)
+type NushellConfigOptions struct {
+	Prompt         bool // PROMPT_INDICATOR, PROMPT_COMMAND
+	EnvConversions bool // ENV_CONVERSIONS para PATH/Path
+	Path           bool // NU_LIB_DIRS, NU_PLUGIN_DIRS, PATH extendido
+	Editor         bool // EDITOR, VISUAL
+	Colors         bool // color_config con el tema XEBEC
+	Completions    bool // completions fuzzy y externas
+	Hooks          bool // init de Starship y zoxide
+}
+
+// GetNushellConfigOptions retorna los módulos disponibles para configurar
+func GetNushellConfigOptions() []ConfigOption {
+	return []ConfigOption{
+		{
+			ID:          "prompt",
+			Title:       "Prompt",
+			Description: "Indicadores de prompt (normal, vi insert, multilinea)",
+			Key:         "prompt",
+		},
+		{
+			ID:          "env_conversions",
+			Title:       "ENV_CONVERSIONS",
+			Description: "Conversión de PATH entre string y lista",
+			Key:         "env_conversions",
+		},
+		{
+			ID:          "path",
+			Title:       "PATH",
+			Description: "~/.local/bin, ~/.cargo/bin, scripts y plugins",
+			Key:         "path",
+		},
+		{
+			ID:          "editor",
+			Title:       "Editor",
+			Description: "EDITOR y VISUAL",
+			Key:         "editor",
+		},
+		{
+			ID:          "colors",
+			Title:       "Colores",
+			Description: "Tema XEBEC para tablas y sintaxis",
+			Key:         "colors",
+		},
+		{
+			ID:          "completions",
+			Title:       "Completado",
+			Description: "Completado fuzzy y externo",
+			Key:         "completions",
+		},
+		{
+			ID:          "hooks",
+			Title:       "Starship + zoxide",
+			Description: "Inicialización de Starship y zoxide",
+			Key:         "hooks",
+		},
+	}
+}
+
+// NushellOptionsFromIDs construye las opciones a partir de los IDs marcados
+func NushellOptionsFromIDs(ids []string) NushellConfigOptions {
+	return NushellConfigOptions{
+		Prompt:         containsID(ids, "prompt"),
+		EnvConversions: containsID(ids, "env_conversions"),
+		Path:           containsID(ids, "path"),
+		Editor:         containsID(ids, "editor"),
+		Colors:         containsID(ids, "colors"),
+		Completions:    containsID(ids, "completions"),
+		Hooks:          containsID(ids, "hooks"),
+	}
+}
+
+// IsEmpty indica si no se seleccionó ningún módulo
+func (o NushellConfigOptions) IsEmpty() bool {
+	return !o.Prompt && !o.EnvConversions && !o.Path && !o.Editor && !o.Colors && !o.Completions && !o.Hooks
+}
+
+// modules retorna el mapa de módulos usado por las plantillas
+func (o NushellConfigOptions) modules() map[string]bool {
+	return map[string]bool{
+		"prompt":          o.Prompt,
+		"env_conversions": o.EnvConversions,
+		"path":            o.Path,
+		"editor":          o.Editor,
+		"colors":          o.Colors,
+		"completions":     o.Completions,
+		"hooks":           o.Hooks,
+	}
+}
+
+// NushellTemplateData datos para renderizar las plantillas de Nushell
+type NushellTemplateData struct {
+	Modules    map[string]bool
+	Home       string
+	ConfigPath string
+	EnvPath    string
+	Editor     string
+	Palette    TerminalPalette
+	// Bloques gestionados de Starship y zoxide (con marcadores xebec)
+	StarshipEnv    string
+	StarshipConfig string
+	ZoxideEnv      string
+	ZoxideConfig   string
+}
+
+// GetNushellConfigDir retorna $nu.default-config-dir según el SO
+func GetNushellConfigDir() string {
+	if xdgConfig := os.Getenv("XDG_CONFIG_HOME"); xdgConfig != "" && runtime.GOOS != "windows" {
+		return filepath.Join(xdgConfig, "nushell")
+	}
+	switch runtime.GOOS {
+	case "windows":
+		return filepath.Join(os.Getenv("APPDATA"), "nushell")
+	case "darwin":
+		return filepath.Join(os.Getenv("HOME"), "Library", "Application Support", "nushell")
+	case "linux":
+		return filepath.Join(os.Getenv("HOME"), ".config", "nushell")
+	}
+	return ""
+}
+
+// GetNushellConfigPath retorna la ruta de config.nu
+func GetNushellConfigPath() string {
+	return filepath.Join(GetNushellConfigDir(), "config.nu")
+}
+
+// GetNushellEnvPath retorna la ruta de env.nu
+func GetNushellEnvPath() string {
+	return filepath.Join(GetNushellConfigDir(), "env.nu")
+}
+
+// GetNushellSourceDir retorna el directorio con las plantillas base de Nushell
+func GetNushellSourceDir() string {
+	_, currentFile, _, _ := runtime.Caller(0)
+	projectRoot := filepath.Dir(filepath.Dir(filepath.Dir(currentFile)))
+	return filepath.Join(projectRoot, "nushell")
+}
+
+// NewNushellTemplateData construye los datos de plantilla para la máquina actual
+func NewNushellTemplateData(opts NushellConfigOptions) NushellTemplateData {
+	return NushellTemplateData{
+		Modules:        opts.modules(),
+		Home:           userHome(),
+		ConfigPath:     GetNushellConfigPath(),
+		EnvPath:        GetNushellEnvPath(),
+		Editor:         detectEditor(),
+		Palette:        XebecPalette(),
+		StarshipEnv:    ShellSyntax.Format("starship", nushellStarshipEnv()),
+		StarshipConfig: ShellSyntax.Format("starship", nushellStarshipConfig()),
+		ZoxideEnv:      ShellSyntax.Format("zoxide", nushellZoxideEnv()),
+		ZoxideConfig:   ShellSyntax.Format("zoxide", nushellZoxideConfig()),
+	}
+}
+
+// nushellZoxideInitPath retorna la ruta del script de init de zoxide para Nushell
+func nushellZoxideInitPath() string {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		cacheDir = filepath.Join(userHome(), ".cache")
+	}
+	return filepath.Join(cacheDir, "zoxide", "init.nu")
+}
+
+// nushellZoxideEnv genera el script de init de zoxide desde env.nu
+func nushellZoxideEnv() string {
+	return "if (which zoxide | is-not-empty) {\n" +
+		fmt.Sprintf("  zoxide init nushell | save -f '%s'\n", nushellZoxideInitPath()) +
+		"}"
+}
+
+// nushellZoxideConfig carga el script de init de zoxide desde config.nu
+func nushellZoxideConfig() string {
+	return fmt.Sprintf("source '%s'", nushellZoxideInitPath())
+}
+
+// RenderNushellConfig genera el contenido de env.nu y config.nu a partir de las plantillas
+func RenderNushellConfig(envTemplate, configTemplate string, data NushellTemplateData) (env string, config string, err error) {
+	env, err = renderTemplate("env.nu", envTemplate, data)
+	if err != nil {
+		return "", "", err
+	}
+	config, err = renderTemplate("config.nu", configTemplate, data)
+	if err != nil {
+		return "", "", err
+	}
+	return env, config, nil
+}
+
+// ConfigureNushell aplica la configuración de Nushell según los módulos seleccionados
+func ConfigureNushell(opts NushellConfigOptions) (err error) {
+	defer recordConfigured("nushell", opts.modules(), &err)
+
+	// Verificar que Nushell esté instalado
+	if !IsNushellInstalled() {
+		return fmt.Errorf("Nushell no está instalado en el sistema")
+	}
+
+	// Crear directorio si no existe
+	dir := GetNushellConfigDir()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("error creando directorio %s: %w", dir, err)
+	}
+
+	// Leer plantillas base
+	sourceDir := GetNushellSourceDir()
+	envTemplate, err := os.ReadFile(filepath.Join(sourceDir, "env.nu"))
+	if err != nil {
+		return fmt.Errorf("error leyendo plantilla env.nu: %w", err)
+	}
+	configTemplate, err := os.ReadFile(filepath.Join(sourceDir, "config.nu"))
+	if err != nil {
+		return fmt.Errorf("error leyendo plantilla config.nu: %w", err)
+	}
+
+	data := NewNushellTemplateData(opts)
+	envContent, configContent, err := RenderNushellConfig(string(envTemplate), string(configTemplate), data)
+	if err != nil {
+		return fmt.Errorf("error generando configuración: %w", err)
+	}
+
+	// source exige que los scripts existan al parsear config.nu
+	if opts.Hooks {
+		for _, p := range []string{nushellStarshipInitPath(), nushellZoxideInitPath()} {
+			if err := ensureFile(p); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Backup y escritura de cada archivo
+	files := []struct {
+		path    string
+		content string
+	}{
+		{data.EnvPath, envContent},
+		{data.ConfigPath, configContent},
+	}
+	for _, f := range files {
+		backupPath, err := BackupFile(f.path)
+		if err != nil {
+			return fmt.Errorf("error en backup: %w", err)
+		}
+		if backupPath != "" {
+			fmt.Printf("✓ Backup creado: %s\n", backupPath)
+		}
+
+		if err := os.WriteFile(f.path, []byte(f.content), 0644); err != nil {
+			return fmt.Errorf("error escribiendo %s: %w", f.path, err)
+		}
+		trackWrite(f.path, f.content)
+		fmt.Printf("✓ Configuración aplicada: %s\n", f.path)
+	}
+
+	return nil
+}
+
+// IsNushellInstalled verifica si Nushell está instalado
+func IsNushellInstalled() bool {
+	paths := []string{"nu"}
+	if runtime.GOOS == "windows" {
+		paths = []string{"nu.exe", "nu"}
+	}
+
+	for _, p := range paths {
+		if _, err := exec.LookPath(p); err == nil {
+			return true
+		}
+	}
+
+	// En Windows también buscar en la ubicación del instalador MSI
+	if runtime.GOOS == "windows" {
+		p := filepath.Join(os.Getenv("LOCALAPPDATA"), "Programs", "nu", "bin", "nu.exe")
+		if _, err := os.Stat(p); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetNushellStatus retorna el estado actual de Nushell
+func GetNushellStatus() (installed bool, configured bool, configPath string) {
+	configPath = GetNushellConfigPath()
+	installed = IsNushellInstalled()
+
+	if _, err := os.Stat(configPath); err == nil {
+		configured = true
+	}
+
+	return
+}
+
+// templateFuncs funciones disponibles en las plantillas
+var templateFuncs = template.FuncMap{
+	"rgb":      hexToRGB,
+	"bare":     bareHex,
+	"gvariant": gvariantString,
+	"toml":     tomlString,
+	"mix":      mixHex,
+	"sgr":      hexToSGR,
+}
+
+// renderTemplate ejecuta una plantilla de texto con los datos dados
+func renderTemplate(name, content string, data interface{}) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=zero").Funcs(templateFuncs).Parse(content)
+	if err != nil {
+		return "", fmt.Errorf("error parseando plantilla %s: %w", name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("error ejecutando plantilla %s: %w", name, err)
+	}
+	return buf.String(), nil
+}
+
+// ensureFile crea un archivo vacío (y sus directorios) si no existe
+func ensureFile(path string) error {
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("error creando directorio %s: %w", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, nil, 0644); err != nil {
+		return fmt.Errorf("error creando %s: %w", path, err)
+	}
+	return nil
+}
+
+// detectEditor retorna el primer editor disponible en PATH
+func detectEditor() string {
+	for _, editor := range []string{"nvim", "vim", "hx", "nano"} {
+		if _, err := exec.LookPath(editor); err == nil {
+			return editor
+		}
+	}
+	if runtime.GOOS == "windows" {
+		return "notepad"
+	}
+	return "nvim"
+}
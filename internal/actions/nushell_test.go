@@ -0,0 +1,66 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRenderNushellConfigGolden(t *testing.T) {
+	goldenHome(t)
+	sourceDir := GetNushellSourceDir()
+	envTemplate, err := os.ReadFile(filepath.Join(sourceDir, "env.nu"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	configTemplate, err := os.ReadFile(filepath.Join(sourceDir, "config.nu"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		ids  []string
+	}{
+		{name: "all", ids: Configurator{Options: GetNushellConfigOptions}.DefaultSections()},
+		{name: "colors", ids: []string{"colors"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := NewNushellTemplateData(NushellOptionsFromIDs(tt.ids))
+			env, config, err := RenderNushellConfig(string(envTemplate), string(configTemplate), data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertGolden(t, "nushell-"+tt.name+"-env.nu", env)
+			assertGolden(t, "nushell-"+tt.name+"-config.nu", config)
+		})
+	}
+}
+
+func TestRenderNushellConfigKeepsStartDirectory(t *testing.T) {
+	goldenHome(t)
+	sourceDir := GetNushellSourceDir()
+	envTemplate, err := os.ReadFile(filepath.Join(sourceDir, "env.nu"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	configTemplate, err := os.ReadFile(filepath.Join(sourceDir, "config.nu"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	all := NushellOptionsFromIDs(Configurator{Options: GetNushellConfigOptions}.DefaultSections())
+	env, config, err := RenderNushellConfig(string(envTemplate), string(configTemplate), NewNushellTemplateData(all))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Nushell debe abrir en el directorio desde el que se lanza, no en ~
+	for name, content := range map[string]string{"env.nu": env, "config.nu": config} {
+		for _, line := range strings.Split(content, "\n") {
+			if strings.HasPrefix(strings.TrimSpace(line), "cd ") {
+				t.Errorf("%s cambia de directorio al arrancar: %s", name, line)
+			}
+		}
+	}
+}
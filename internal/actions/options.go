@@ -0,0 +1,25 @@
+// Package: actions
+// Opciones seleccionables compartidas por los configuradores
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+// ConfigOption representa una opción en el menú de checkboxes
+type ConfigOption struct {
+	ID          string
+	Title       string
+	Description string
+	Key         string
+	Group       string // Opciones del mismo grupo son excluyentes (radio)
+}
+
+// containsID verifica si un ID está en la lista de opciones marcadas
+func containsID(ids []string, id string) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,208 @@
+// Package: actions
+// Paleta de terminal XEBEC compartida por los configuradores
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/XebecCorporation/XebecCorporation.Dots/themes"
+)
+
+// ANSIColors los 8 colores ANSI de una fila (normal o bright)
+type ANSIColors struct {
+	Black   string `json:"black"`
+	Red     string `json:"red"`
+	Green   string `json:"green"`
+	Yellow  string `json:"yellow"`
+	Blue    string `json:"blue"`
+	Magenta string `json:"magenta"`
+	Cyan    string `json:"cyan"`
+	White   string `json:"white"`
+}
+
+// List retorna los colores en el orden ANSI (0-7)
+func (c ANSIColors) List() []string {
+	return []string{c.Black, c.Red, c.Green, c.Yellow, c.Blue, c.Magenta, c.Cyan, c.White}
+}
+
+// TerminalPalette esquema de colores de un terminal
+type TerminalPalette struct {
+	Name       string     `json:"name"`
+	Background string     `json:"background"`
+	Foreground string     `json:"foreground"`
+	Cursor     string     `json:"cursor"`
+	Selection  string     `json:"selection"`
+	Normal     ANSIColors `json:"normal"`
+	Bright     ANSIColors `json:"bright"`
+}
+
+// UIColors acentos de la interfaz del CLI (estilos de lipgloss)
+type UIColors struct {
+	Primary       string `json:"primary"`
+	Secondary     string `json:"secondary"`
+	White         string `json:"white"`
+	AccentCyan    string `json:"accent_cyan"`
+	AccentGreen   string `json:"accent_green"`
+	AccentOrange  string `json:"accent_orange"`
+	AccentRed     string `json:"accent_red"`
+	AccentYellow  string `json:"accent_yellow"`
+	AccentPurple  string `json:"accent_purple"`
+	GradientStart string `json:"gradient_start"`
+	GradientEnd   string `json:"gradient_end"`
+	GrayDark      string `json:"gray_dark"`
+	Gray          string `json:"gray"`
+	GrayLight     string `json:"gray_light"`
+	GrayLighter   string `json:"gray_lighter"`
+}
+
+// Theme modelo canónico de un tema: paleta de terminal, variante y acentos de UI
+type Theme struct {
+	TerminalPalette
+	Variant string   `json:"variant"` // "dark" o "light"
+	UI      UIColors `json:"ui"`
+}
+
+// hexColorRe formato #RRGGBB aceptado en los temas
+var hexColorRe = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// ParseTheme decodifica un tema JSON y valida sus colores
+func ParseTheme(data []byte) (Theme, error) {
+	var theme Theme
+	if err := json.Unmarshal(data, &theme); err != nil {
+		return Theme{}, fmt.Errorf("error parseando tema: %w", err)
+	}
+	if theme.Name == "" {
+		return Theme{}, fmt.Errorf("el tema no tiene nombre")
+	}
+	if theme.Variant == "" {
+		theme.Variant = "dark"
+	}
+	if theme.UI == (UIColors{}) {
+		theme.UI = deriveUIColors(theme.TerminalPalette)
+	}
+	colors := map[string]string{
+		"background": theme.Background,
+		"foreground": theme.Foreground,
+		"cursor":     theme.Cursor,
+		"selection":  theme.Selection,
+	}
+	names := []string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+	for i, c := range theme.Normal.List() {
+		colors["normal."+names[i]] = c
+	}
+	for i, c := range theme.Bright.List() {
+		colors["bright."+names[i]] = c
+	}
+	for key, c := range colors {
+		if !hexColorRe.MatchString(c) {
+			return Theme{}, fmt.Errorf("color %s inválido en el tema %s: %q", key, theme.Name, c)
+		}
+	}
+	return theme, nil
+}
+
+// DefaultTheme tema por defecto incluido en el binario; no lee el estado ni el sistema
+func DefaultTheme() Theme {
+	data, err := themes.Builtin.ReadFile(themes.Default)
+	if err != nil {
+		panic(fmt.Sprintf("tema por defecto no encontrado: %v", err))
+	}
+	theme, err := ParseTheme(data)
+	if err != nil {
+		panic(fmt.Sprintf("tema por defecto inválido: %v", err))
+	}
+	return theme
+}
+
+var (
+	activeTheme     Theme
+	activeThemeOnce sync.Once
+)
+
+// XebecTheme tema activo (por defecto themes/xebec.json); fuente única de colores
+// Se carga al usarlo por primera vez, no al arrancar: xebec version o --help no leen el estado
+func XebecTheme() Theme {
+	activeThemeOnce.Do(func() { activeTheme = loadActiveTheme() })
+	return activeTheme
+}
+
+// XebecPalette paleta de terminal del tema activo
+func XebecPalette() TerminalPalette {
+	return XebecTheme().TerminalPalette
+}
+
+// GetUserThemesDir retorna el directorio de los temas importados
+func GetUserThemesDir() string {
+	return filepath.Join(GetXebecDataDir(), "themes")
+}
+
+// ThemeSlug nombre de archivo de un tema: minúsculas y guiones
+func ThemeSlug(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(strings.TrimSpace(name)) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			dash = false
+		} else if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
+
+// SaveTheme guarda un tema en el directorio de temas del usuario y retorna su ruta
+// Sin overwrite no sustituye un tema ya guardado ni oculta uno integrado (LoadTheme lee antes los del usuario)
+func SaveTheme(theme Theme, overwrite bool) (string, error) {
+	slug := ThemeSlug(theme.Name)
+	if slug == "" {
+		return "", fmt.Errorf("nombre de tema inválido: %q", theme.Name)
+	}
+	path := filepath.Join(GetUserThemesDir(), slug+".json")
+	if !overwrite {
+		if _, err := themes.Builtin.ReadFile(slug + ".json"); err == nil {
+			return "", fmt.Errorf("%s es un tema integrado; usa otro nombre o --force para reemplazarlo", slug)
+		}
+		if _, err := os.Stat(path); err == nil {
+			return "", fmt.Errorf("ya existe el tema %s (%s); usa otro nombre o --force para sobrescribirlo", slug, path)
+		}
+	}
+	data, err := json.MarshalIndent(theme, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("error generando tema: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", fmt.Errorf("error creando directorio %s: %w", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return "", fmt.Errorf("error escribiendo %s: %w", path, err)
+	}
+	return path, nil
+}
+
+// LoadTheme carga un tema por nombre: primero los importados, luego los integrados
+func LoadTheme(name string) (Theme, error) {
+	slug := ThemeSlug(name)
+	if slug == "" {
+		return Theme{}, fmt.Errorf("nombre de tema inválido: %q", name)
+	}
+
+	data, err := os.ReadFile(filepath.Join(GetUserThemesDir(), slug+".json"))
+	if err != nil {
+		if data, err = themes.Builtin.ReadFile(slug + ".json"); err != nil {
+			return Theme{}, fmt.Errorf("tema no encontrado: %s", name)
+		}
+	}
+	return ParseTheme(data)
+}
@@ -0,0 +1,249 @@
+// Package: actions
+// Acciones de configuración del perfil de PowerShell
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// Opciones de configuración de PowerShell (bloques gestionados en $PROFILE)
+type PowerShellConfigOptions struct {
+	PSReadLine bool // Historial, búsqueda con ↑↓, menú de completado
+	Colors     bool // Colores XEBEC para PSReadLine y $PSStyle
+	Starship   bool // Init de Starship
+	Zoxide     bool // Init de zoxide
+	Aliases    bool // ll, .., ..., which, vim → nvim
+}
+
+// GetPowerShellConfigOptions retorna los bloques disponibles para configurar
+func GetPowerShellConfigOptions() []ConfigOption {
+	return []ConfigOption{
+		{ID: "psreadline", Title: "PSReadLine", Description: "Historial sin duplicados, búsqueda con ↑↓, Tab con menú", Key: "psreadline"},
+		{ID: "colors", Title: "Colores", Description: "Tema XEBEC para la sintaxis y los listados", Key: "colors"},
+		{ID: "starship", Title: "Starship", Description: "Inicializar el prompt de Starship", Key: "starship"},
+		{ID: "zoxide", Title: "zoxide", Description: "Inicializar zoxide (comando z)", Key: "zoxide"},
+		{ID: "aliases", Title: "Aliases", Description: "ll, .., ..., which y vim → nvim", Key: "aliases"},
+	}
+}
+
+// PowerShellOptionsFromIDs construye las opciones a partir de los IDs marcados
+func PowerShellOptionsFromIDs(ids []string) PowerShellConfigOptions {
+	return PowerShellConfigOptions{
+		PSReadLine: containsID(ids, "psreadline"),
+		Colors:     containsID(ids, "colors"),
+		Starship:   containsID(ids, "starship"),
+		Zoxide:     containsID(ids, "zoxide"),
+		Aliases:    containsID(ids, "aliases"),
+	}
+}
+
+// IsEmpty indica si no se seleccionó ningún bloque
+func (o PowerShellConfigOptions) IsEmpty() bool {
+	return !o.PSReadLine && !o.Colors && !o.Starship && !o.Zoxide && !o.Aliases
+}
+
+// modules retorna los bloques marcados por ID
+func (o PowerShellConfigOptions) modules() map[string]bool {
+	return map[string]bool{
+		"psreadline": o.PSReadLine,
+		"colors":     o.Colors,
+		"starship":   o.Starship,
+		"zoxide":     o.Zoxide,
+		"aliases":    o.Aliases,
+	}
+}
+
+// PowerShellProfile perfil CurrentUserCurrentHost de una edición de PowerShell
+type PowerShellProfile struct {
+	Edition   string // "Windows PowerShell 5.1", "PowerShell 7"
+	Binary    string // powershell, pwsh
+	Path      string
+	Installed bool
+}
+
+// GetPowerShellProfiles retorna los perfiles de las ediciones disponibles en el SO
+// Windows PowerShell 5.1 solo existe en Windows; pwsh también en Linux y macOS
+func GetPowerShellProfiles() []PowerShellProfile {
+	profiles := []PowerShellProfile{
+		{Edition: "PowerShell 7", Binary: "pwsh", Path: GetPowerShellProfilePath()},
+	}
+	if runtime.GOOS == "windows" {
+		profiles = append(profiles, PowerShellProfile{
+			Edition: "Windows PowerShell 5.1",
+			Binary:  "powershell",
+			Path:    GetWindowsPowerShellProfilePath(),
+		})
+	}
+
+	for i := range profiles {
+		profiles[i].Installed = isAnyBinaryInstalled([]string{profiles[i].Binary})
+		if !profiles[i].Installed {
+			continue
+		}
+		// $PROFILE contempla Documentos redirigidos (p. ej. OneDrive)
+		if p := queryPowerShellProfile(profiles[i].Binary); p != "" {
+			profiles[i].Path = p
+		}
+	}
+	return profiles
+}
+
+// queryPowerShellProfile pregunta a PowerShell por su $PROFILE
+func queryPowerShellProfile(binary string) string {
+	out, err := exec.Command(binary, "-NoProfile", "-NonInteractive", "-Command", "$PROFILE.CurrentUserCurrentHost").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// PowerShellBlocks retorna los bloques gestionados a inyectar en $PROFILE
+// El contenido es ASCII para que Windows PowerShell 5.1 lo lea sin BOM
+func PowerShellBlocks(opts PowerShellConfigOptions) []ManagedBlock {
+	var blocks []ManagedBlock
+	if opts.PSReadLine {
+		blocks = append(blocks, ManagedBlock{Component: "psreadline", Body: powerShellPSReadLine()})
+	}
+	if opts.Colors {
+		blocks = append(blocks, ManagedBlock{Component: "colors", Body: powerShellColors()})
+	}
+	if opts.Starship {
+		blocks = append(blocks, ManagedBlock{Component: "starship", Body: powerShellStarship()})
+	}
+	if opts.Zoxide {
+		blocks = append(blocks, ManagedBlock{Component: "zoxide", Body: powerShellZoxide()})
+	}
+	if opts.Aliases {
+		blocks = append(blocks, ManagedBlock{Component: "aliases", Body: powerShellAliases()})
+	}
+	return blocks
+}
+
+func powerShellPSReadLine() string {
+	return strings.Join([]string{
+		"if (Get-Module -ListAvailable -Name PSReadLine) {",
+		"  Import-Module PSReadLine",
+		"  Set-PSReadLineOption -EditMode Windows -HistoryNoDuplicates -HistorySearchCursorMovesToEnd -BellStyle None",
+		"  if ((Get-Module PSReadLine).Version -ge [version]'2.1.0') {",
+		"    Set-PSReadLineOption -PredictionSource History",
+		"  }",
+		"  Set-PSReadLineKeyHandler -Key UpArrow -Function HistorySearchBackward",
+		"  Set-PSReadLineKeyHandler -Key DownArrow -Function HistorySearchForward",
+		"  Set-PSReadLineKeyHandler -Key Tab -Function MenuComplete",
+		"}",
+	}, "\n")
+}
+
+// powerShellColors usa secuencias ANSI: 5.1 no admite `e ni colores hex
+func powerShellColors() string {
+	color := func(hex string) string { return "$esc[" + hexToSGR(hex) + "m" }
+	return strings.Join([]string{
+		"$esc = [char]27",
+		"if (Get-Module -ListAvailable -Name PSReadLine) {",
+		"  Set-PSReadLineOption -Colors @{",
+		fmt.Sprintf("    Command   = \"%s\"", color(XebecPalette().Normal.Blue)),
+		fmt.Sprintf("    Parameter = \"%s\"", color(XebecPalette().Bright.Blue)),
+		fmt.Sprintf("    Operator  = \"%s\"", color(XebecPalette().Normal.Yellow)),
+		fmt.Sprintf("    Variable  = \"%s\"", color(XebecPalette().Bright.Magenta)),
+		fmt.Sprintf("    String    = \"%s\"", color(XebecPalette().Normal.Green)),
+		fmt.Sprintf("    Number    = \"%s\"", color(XebecPalette().Normal.Cyan)),
+		fmt.Sprintf("    Type      = \"%s\"", color(XebecPalette().Bright.Cyan)),
+		fmt.Sprintf("    Comment   = \"%s\"", color(XebecPalette().Bright.Black)),
+		fmt.Sprintf("    Keyword   = \"%s\"", color(XebecPalette().Normal.Magenta)),
+		fmt.Sprintf("    Error     = \"%s\"", color(XebecPalette().Normal.Red)),
+		"  }",
+		"}",
+		"if ($PSStyle) {",
+		fmt.Sprintf("  $PSStyle.FileInfo.Directory = \"$esc[1;%sm\"", hexToSGR(XebecPalette().Normal.Blue)),
+		fmt.Sprintf("  $PSStyle.FileInfo.SymbolicLink = \"%s\"", color(XebecPalette().Normal.Cyan)),
+		fmt.Sprintf("  $PSStyle.FileInfo.Executable = \"%s\"", color(XebecPalette().Normal.Green)),
+		"}",
+	}, "\n")
+}
+
+// powerShellStarship coincide con el bloque que escribe el configurador de Starship
+func powerShellStarship() string {
+	return starshipInitLine("powershell")
+}
+
+func powerShellZoxide() string {
+	return strings.Join([]string{
+		"if (Get-Command zoxide -ErrorAction SilentlyContinue) {",
+		"  Invoke-Expression (& { (zoxide init powershell | Out-String) })",
+		"}",
+	}, "\n")
+}
+
+func powerShellAliases() string {
+	return strings.Join([]string{
+		"function ll { Get-ChildItem -Force @args }",
+		"function .. { Set-Location .. }",
+		"function ... { Set-Location ../.. }",
+		"Set-Alias -Name which -Value Get-Command",
+		"if (Get-Command nvim -ErrorAction SilentlyContinue) { Set-Alias -Name vim -Value nvim }",
+	}, "\n")
+}
+
+// ConfigurePowerShell inyecta los bloques seleccionados en el $PROFILE de cada edición
+// No exige PowerShell instalado: el perfil queda listo para la primera sesión
+func ConfigurePowerShell(opts PowerShellConfigOptions) (err error) {
+	defer recordConfigured("powershell", opts.modules(), &err)
+
+	return ConfigurePowerShellProfiles(GetPowerShellProfiles(), opts)
+}
+
+// ConfigurePowerShellProfiles inyecta los bloques seleccionados en los perfiles dados
+func ConfigurePowerShellProfiles(profiles []PowerShellProfile, opts PowerShellConfigOptions) error {
+	blocks := PowerShellBlocks(opts)
+	if opts.Starship && !IsStarshipInstalled() {
+		fmt.Println("⚠ Starship no está instalado; instálalo antes de abrir una nueva sesión")
+	}
+	for _, profile := range profiles {
+		if !profile.Installed {
+			fmt.Printf("⚠ %s no está instalado; se prepara igualmente %s\n", profile.Edition, profile.Path)
+		}
+
+		changed := false
+		for _, block := range blocks {
+			result, err := UpsertBlockInFile(profile.Path, block.Component, block.Body)
+			if err != nil {
+				return fmt.Errorf("error configurando %s: %w", profile.Edition, err)
+			}
+			if result.Drifted {
+				fmt.Printf("⚠ El bloque xebec:%s de %s tenía cambios manuales (backup: %s)\n", block.Component, result.Path, result.BackupPath)
+			}
+			changed = changed || result.Changed
+		}
+
+		if changed {
+			fmt.Printf("✓ Configuración aplicada: %s (%s)\n", profile.Path, profile.Edition)
+		} else {
+			fmt.Printf("• %s ya está actualizado\n", profile.Edition)
+		}
+	}
+	return nil
+}
+
+// GetPowerShellStatus retorna el estado del perfil de la primera edición instalada
+func GetPowerShellStatus() (installed bool, configured bool, configPath string) {
+	for _, profile := range GetPowerShellProfiles() {
+		if !profile.Installed {
+			continue
+		}
+		_, err := os.Stat(profile.Path)
+		return true, err == nil, profile.Path
+	}
+	return false, false, GetPowerShellProfilePath()
+}
+
+// IsPowerShellInstalled verifica si alguna edición de PowerShell está instalada
+func IsPowerShellInstalled() bool {
+	return isAnyBinaryInstalled([]string{"pwsh", "powershell"})
+}
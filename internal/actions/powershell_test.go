@@ -0,0 +1,136 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPowerShellBlocks(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       PowerShellConfigOptions
+		components []string
+		contains   map[string]string // Componente -> línea esperada en su bloque
+	}{
+		{
+			name:       "ninguno",
+			opts:       PowerShellConfigOptions{},
+			components: nil,
+		},
+		{
+			name:       "todos",
+			opts:       PowerShellConfigOptions{PSReadLine: true, Colors: true, Starship: true, Zoxide: true, Aliases: true},
+			components: []string{"psreadline", "colors", "starship", "zoxide", "aliases"},
+			contains: map[string]string{
+				"psreadline": "Set-PSReadLineKeyHandler -Key Tab -Function MenuComplete",
+				"colors":     "$esc = [char]27",
+				"starship":   "Invoke-Expression (&starship init powershell)",
+				"zoxide":     "zoxide init powershell",
+				"aliases":    "Set-Alias -Name which -Value Get-Command",
+			},
+		},
+		{
+			name:       "solo aliases y zoxide",
+			opts:       PowerShellConfigOptions{Zoxide: true, Aliases: true},
+			components: []string{"zoxide", "aliases"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blocks := PowerShellBlocks(tt.opts)
+			if len(blocks) != len(tt.components) {
+				t.Fatalf("got %d bloques, want %d", len(blocks), len(tt.components))
+			}
+			for i, block := range blocks {
+				if block.Component != tt.components[i] {
+					t.Errorf("bloque %d: got %q, want %q", i, block.Component, tt.components[i])
+				}
+				// Windows PowerShell 5.1 lee los perfiles sin BOM como ANSI
+				for _, r := range block.Body {
+					if r > 0x7f {
+						t.Errorf("bloque %s: carácter no ASCII %q", block.Component, r)
+						break
+					}
+				}
+				// `e solo existe desde PowerShell 6
+				if strings.Contains(block.Body, "`e") {
+					t.Errorf("bloque %s usa `e, que 5.1 no entiende", block.Component)
+				}
+				if want, ok := tt.contains[block.Component]; ok && !strings.Contains(block.Body, want) {
+					t.Errorf("bloque %s: falta %q en:\n%s", block.Component, want, block.Body)
+				}
+			}
+		})
+	}
+}
+
+func TestConfigurePowerShellProfiles(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_DATA_HOME", filepath.Join(dir, "data"))
+
+	profiles := []PowerShellProfile{
+		{Edition: "PowerShell 7", Binary: "pwsh", Path: filepath.Join(dir, "PowerShell", "Microsoft.PowerShell_profile.ps1"), Installed: true},
+		{Edition: "Windows PowerShell 5.1", Binary: "powershell", Path: filepath.Join(dir, "WindowsPowerShell", "Microsoft.PowerShell_profile.ps1"), Installed: true},
+	}
+	userLine := "Set-Location C:\\src"
+	for _, p := range profiles {
+		if err := os.MkdirAll(filepath.Dir(p.Path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p.Path, []byte(userLine+"\r\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	opts := PowerShellConfigOptions{PSReadLine: true, Aliases: true}
+	if err := ConfigurePowerShellProfiles(profiles, opts); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, p := range profiles {
+		t.Run(p.Edition, func(t *testing.T) {
+			data, err := os.ReadFile(p.Path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			content := string(data)
+			for _, want := range []string{
+				userLine,
+				"# >>> xebec:psreadline >>>",
+				"# xebec:checksum ",
+				"# <<< xebec:psreadline <<<",
+				"# >>> xebec:aliases >>>",
+				"# <<< xebec:aliases <<<",
+			} {
+				if !strings.Contains(content, want) {
+					t.Errorf("falta %q en:\n%s", want, content)
+				}
+			}
+			if strings.Contains(content, "xebec:colors") {
+				t.Error("no se pidió el bloque colors")
+			}
+			// El perfil tenía CRLF y debe conservarlo
+			if strings.Count(content, "\n") != strings.Count(content, "\r\n") {
+				t.Error("se mezclaron finales de línea")
+			}
+			for _, info := range SyntaxForPath(p.Path).List(content) {
+				if info.Status != BlockInSync {
+					t.Errorf("bloque %s: %s", info.Component, info.Status)
+				}
+			}
+		})
+	}
+
+	// Una segunda pasada no cambia nada
+	before, _ := os.ReadFile(profiles[0].Path)
+	if err := ConfigurePowerShellProfiles(profiles, opts); err != nil {
+		t.Fatal(err)
+	}
+	after, _ := os.ReadFile(profiles[0].Path)
+	if string(before) != string(after) {
+		t.Errorf("la segunda pasada cambió el perfil:\n%s", after)
+	}
+}
@@ -0,0 +1,125 @@
+// Package: actions
+// Opciones y datos comunes de los terminales configurados con un perfil XEBEC
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import "strings"
+
+// Opciones de configuración de un perfil de terminal (mismos grupos que Alacritty)
+type TerminalProfileOptions struct {
+	Window bool // opacidad, padding
+	Colors bool // esquema de colores XEBEC
+	Font   bool // JetBrains Mono
+	Cursor bool // barra parpadeante
+	Shell  bool // Nushell como comando del perfil
+}
+
+// GetTerminalProfileOptions retorna las opciones disponibles para configurar
+func GetTerminalProfileOptions() []ConfigOption {
+	return []ConfigOption{
+		{ID: "window", Title: "Ventana", Description: "Opacidad 85%, sin padding", Key: "window"},
+		{ID: "colors", Title: "Colores", Description: "Esquema XEBEC - 16 colores, cursor, selección", Key: "colors"},
+		{ID: "font", Title: "Fuente", Description: "JetBrains Mono, tamaño", Key: "font"},
+		{ID: "cursor", Title: "Cursor", Description: "Barra parpadeante", Key: "cursor"},
+		{ID: "shell", Title: "Shell", Description: "Nushell como comando", Key: "shell"},
+	}
+}
+
+// TerminalProfileOptionsFromIDs construye las opciones a partir de los IDs marcados
+func TerminalProfileOptionsFromIDs(ids []string) TerminalProfileOptions {
+	return TerminalProfileOptions{
+		Window: containsID(ids, "window"),
+		Colors: containsID(ids, "colors"),
+		Font:   containsID(ids, "font"),
+		Cursor: containsID(ids, "cursor"),
+		Shell:  containsID(ids, "shell"),
+	}
+}
+
+// IsEmpty indica si no se seleccionó ninguna opción
+func (o TerminalProfileOptions) IsEmpty() bool {
+	return !o.Window && !o.Colors && !o.Font && !o.Cursor && !o.Shell
+}
+
+// modules retorna el mapa de módulos usado por las plantillas
+func (o TerminalProfileOptions) modules() map[string]bool {
+	return map[string]bool{
+		"window": o.Window,
+		"colors": o.Colors,
+		"font":   o.Font,
+		"cursor": o.Cursor,
+		"shell":  o.Shell,
+	}
+}
+
+// ProfileTemplateData datos comunes de las plantillas de perfil
+type ProfileTemplateData struct {
+	Modules map[string]bool
+	Palette TerminalPalette
+	Colors  []string // 16 colores ANSI
+	Program string   // Ruta de Nushell; vacía si no está instalado
+	Command string   // Program con --login, citado como en un shell POSIX
+}
+
+// NewProfileTemplateData construye los datos de plantilla para la máquina actual
+func NewProfileTemplateData(opts TerminalProfileOptions) ProfileTemplateData {
+	data := ProfileTemplateData{
+		Modules: opts.modules(),
+		Palette: XebecPalette(),
+		Colors:  append(XebecPalette().Normal.List(), XebecPalette().Bright.List()...),
+	}
+	// Nushell con --login, igual que la plantilla de Alacritty
+	if shell, err := FindShell("nu"); err == nil {
+		if program, err := ResolveShellProgram(shell); err == nil {
+			data.Program = program
+			data.Command = posixQuote(program) + " --login"
+		}
+	}
+	if !opts.Shell {
+		data.Program, data.Command = "", ""
+	}
+	return data
+}
+
+// hexToRGB convierte #RRGGBB en "R,G,B" (formato de Konsole)
+func hexToRGB(hex string) string {
+	sgr := hexToSGR(hex)
+	if !strings.HasPrefix(sgr, "38;2;") {
+		return "0,0,0"
+	}
+	return strings.ReplaceAll(strings.TrimPrefix(sgr, "38;2;"), ";", ",")
+}
+
+// bareHex quita el # de un color (formato de foot)
+func bareHex(hex string) string {
+	return strings.TrimPrefix(hex, "#")
+}
+
+// gvariantString cita un string para dconf (GVariant)
+func gvariantString(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	return "'" + strings.ReplaceAll(value, "'", `\'`) + "'"
+}
+
+// gvariantStringList formatea una lista de strings para dconf
+func gvariantStringList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = gvariantString(v)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// parseGVariantStringList lee una lista de UUIDs de dconf, p. ej. ['a', 'b'] o @as [] si está vacía
+func parseGVariantStringList(value string) []string {
+	var list []string
+	value = strings.TrimPrefix(strings.TrimSpace(value), "@as")
+	for _, field := range strings.Split(strings.Trim(strings.TrimSpace(value), "[]"), ",") {
+		if s := strings.Trim(strings.TrimSpace(field), `'"`); s != "" {
+			list = append(list, s)
+		}
+	}
+	return list
+}
@@ -0,0 +1,104 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var allProfileOptions = TerminalProfileOptions{Window: true, Colors: true, Font: true, Cursor: true, Shell: true}
+
+func TestRenderGnomeTerminalProfileGolden(t *testing.T) {
+	tmpl, err := os.ReadFile(filepath.Join(GetGnomeTerminalSourceDir(), "xebec.dconf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		golden string
+		opts   TerminalProfileOptions
+	}{
+		{"gnome-terminal-all.dconf", allProfileOptions},
+		{"gnome-terminal-colors.dconf", TerminalProfileOptions{Colors: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.golden, func(t *testing.T) {
+			data := GnomeTerminalTemplateData{ProfileTemplateData: goldenProfileData(tt.opts), UUID: XebecGnomeProfileUUID}
+			out, err := RenderGnomeTerminalProfile(string(tmpl), data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertGolden(t, tt.golden, out)
+		})
+	}
+}
+
+func TestAddGnomeProfileToList(t *testing.T) {
+	tests := []struct {
+		current, want string
+	}{
+		{"", "['" + gnomeDefaultProfileUUID + "', '" + XebecGnomeProfileUUID + "']"},
+		{"@as []", "['" + gnomeDefaultProfileUUID + "', '" + XebecGnomeProfileUUID + "']"},
+		{"['aaaa']", "['aaaa', '" + XebecGnomeProfileUUID + "']"},
+		{"['aaaa', '" + XebecGnomeProfileUUID + "']", "['aaaa', '" + XebecGnomeProfileUUID + "']"},
+	}
+	for _, tt := range tests {
+		if got := AddGnomeProfileToList(tt.current, XebecGnomeProfileUUID); got != tt.want {
+			t.Errorf("AddGnomeProfileToList(%q) = %q, want %q", tt.current, got, tt.want)
+		}
+	}
+}
+
+func TestRenderKonsoleFilesGolden(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+
+	files, err := RenderKonsoleFiles(GetKonsoleSourceDir(), goldenProfileData(allProfileOptions))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertGolden(t, "konsole-XEBEC.profile", files[GetKonsoleProfilePath()])
+	assertGolden(t, "konsole-XEBEC.colorscheme", files[GetKonsoleColorSchemePath()])
+
+	// Sin colores no se genera el esquema
+	files, err = RenderKonsoleFiles(GetKonsoleSourceDir(), goldenProfileData(TerminalProfileOptions{Font: true}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := files[GetKonsoleColorSchemePath()]; ok {
+		t.Error("se generó XEBEC.colorscheme sin la sección colors")
+	}
+}
+
+func TestXfceTerminalGolden(t *testing.T) {
+	sourceDir := GetXfceTerminalSourceDir()
+	data := goldenProfileData(allProfileOptions)
+
+	theme, err := os.ReadFile(filepath.Join(sourceDir, "xebec.theme"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	scheme, err := renderTemplate("xebec.theme", string(theme), data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertGolden(t, "xfce4-terminal-xebec.theme", scheme)
+
+	tmpl, err := os.ReadFile(filepath.Join(sourceDir, "terminalrc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rendered, err := renderTemplate("terminalrc", string(tmpl), data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	current, err := os.ReadFile(filepath.Join("testdata", "xfce4-terminal", "terminalrc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Las claves del usuario se conservan y las de XEBEC se reemplazan en su sitio
+	merged := SetINIValues(string(current), ParseINI(rendered))
+	assertGolden(t, "xfce4-terminal-terminalrc", merged)
+	if again := SetINIValues(merged, ParseINI(rendered)); again != merged {
+		t.Errorf("la segunda fusión cambió terminalrc:\n%s", UnifiedDiff(merged, again, "primera", "segunda"))
+	}
+}
@@ -0,0 +1,93 @@
+// Package: actions
+// Acciones de configuración de Rio
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// GetRioConfigDir retorna el directorio de configuración de Rio
+func GetRioConfigDir() string {
+	if dir := os.Getenv("RIO_CONFIG_HOME"); dir != "" {
+		return dir
+	}
+	if runtime.GOOS == "windows" {
+		return filepath.Join(os.Getenv("APPDATA"), "rio")
+	}
+	return filepath.Join(xdgConfigHome(), "rio")
+}
+
+// GetRioConfigPath retorna la ruta de config.toml
+func GetRioConfigPath() string {
+	return filepath.Join(GetRioConfigDir(), "config.toml")
+}
+
+// GetRioThemePath retorna la ruta del tema XEBEC
+func GetRioThemePath() string {
+	return filepath.Join(GetRioConfigDir(), "themes", "xebec.toml")
+}
+
+// GetRioSourceDir retorna el directorio con las plantillas de Rio
+func GetRioSourceDir() string {
+	_, currentFile, _, _ := runtime.Caller(0)
+	projectRoot := filepath.Dir(filepath.Dir(filepath.Dir(currentFile)))
+	return filepath.Join(projectRoot, "rio")
+}
+
+// ConfigureRio instala el tema XEBEC y fija las claves XEBEC en config.toml
+func ConfigureRio(opts TerminalProfileOptions) (err error) {
+	defer recordConfigured("rio", opts.modules(), &err)
+
+	sourceDir := GetRioSourceDir()
+	data := NewProfileTemplateData(opts)
+	if opts.Shell && data.Program == "" {
+		fmt.Println("⚠ Nushell no está instalado; shell no se modifica")
+	}
+
+	if opts.Colors {
+		tmpl, err := os.ReadFile(filepath.Join(sourceDir, "xebec.toml"))
+		if err != nil {
+			return fmt.Errorf("error leyendo plantilla xebec.toml: %w", err)
+		}
+		theme, err := renderTemplate("xebec.toml", string(tmpl), data)
+		if err != nil {
+			return fmt.Errorf("error generando configuración: %w", err)
+		}
+		if err := writeFragment(GetRioThemePath(), theme); err != nil {
+			return err
+		}
+	}
+
+	tmpl, err := os.ReadFile(filepath.Join(sourceDir, "config.toml"))
+	if err != nil {
+		return fmt.Errorf("error leyendo plantilla config.toml: %w", err)
+	}
+	rendered, err := renderTemplate("config.toml", string(tmpl), data)
+	if err != nil {
+		return fmt.Errorf("error generando configuración: %w", err)
+	}
+	return mergeTOMLFile(GetRioConfigPath(), rendered)
+}
+
+// IsRioInstalled verifica si Rio está instalado
+func IsRioInstalled() bool {
+	return isAnyBinaryInstalled([]string{"rio"})
+}
+
+// GetRioStatus retorna el estado actual de Rio
+func GetRioStatus() (installed bool, configured bool, configPath string) {
+	configPath = GetRioThemePath()
+	installed = IsRioInstalled()
+
+	if _, err := os.Stat(configPath); err == nil {
+		configured = true
+	}
+
+	return
+}
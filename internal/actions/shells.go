@@ -0,0 +1,165 @@
+// Package: actions
+// Detección de shells y rutas de sus archivos de inicio
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// Shell soportado por los configuradores de XEBEC
+type Shell struct {
+	ID        string // "nu", "zsh", "bash", "powershell", "fish"
+	Name      string // Nombre para mostrar
+	Binaries  []string
+	RCPath    string // Archivo de inicio donde se inyectan bloques
+	Installed bool
+}
+
+// GetShells retorna todos los shells soportados con su archivo de inicio
+func GetShells() []Shell {
+	shells := []Shell{
+		{ID: "nu", Name: "Nushell", Binaries: []string{"nu"}, RCPath: GetNushellConfigPath()},
+		{ID: "zsh", Name: "Zsh", Binaries: []string{"zsh"}, RCPath: GetZshRCPath()},
+		{ID: "bash", Name: "Bash", Binaries: []string{"bash"}, RCPath: GetBashRCPath()},
+		{ID: "powershell", Name: "PowerShell", Binaries: []string{"pwsh", "powershell"}, RCPath: GetPowerShellProfilePath()},
+		{ID: "fish", Name: "Fish", Binaries: []string{"fish"}, RCPath: GetFishConfigPath()},
+	}
+
+	for i := range shells {
+		shells[i].Installed = isAnyBinaryInstalled(shells[i].Binaries)
+	}
+	return shells
+}
+
+// DetectShells retorna solo los shells instalados
+func DetectShells() []Shell {
+	var result []Shell
+	for _, s := range GetShells() {
+		if s.Installed {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// GetZshRCPath retorna la ruta de .zshrc (respeta ZDOTDIR)
+func GetZshRCPath() string {
+	if zdotdir := os.Getenv("ZDOTDIR"); zdotdir != "" {
+		return filepath.Join(zdotdir, ".zshrc")
+	}
+	return filepath.Join(userHome(), ".zshrc")
+}
+
+// GetBashRCPath retorna la ruta de .bashrc
+func GetBashRCPath() string {
+	return filepath.Join(userHome(), ".bashrc")
+}
+
+// GetFishConfigPath retorna la ruta de config.fish
+func GetFishConfigPath() string {
+	return filepath.Join(xdgConfigHome(), "fish", "config.fish")
+}
+
+// GetPowerShellProfilePath retorna el $PROFILE de PowerShell 7 (CurrentUserCurrentHost)
+func GetPowerShellProfilePath() string {
+	if runtime.GOOS == "windows" {
+		return filepath.Join(userHome(), "Documents", "PowerShell", "Microsoft.PowerShell_profile.ps1")
+	}
+	return filepath.Join(xdgConfigHome(), "powershell", "Microsoft.PowerShell_profile.ps1")
+}
+
+// GetWindowsPowerShellProfilePath retorna el $PROFILE de Windows PowerShell 5.1
+func GetWindowsPowerShellProfilePath() string {
+	return filepath.Join(userHome(), "Documents", "WindowsPowerShell", "Microsoft.PowerShell_profile.ps1")
+}
+
+// userHome retorna el directorio home del usuario
+func userHome() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return os.Getenv("HOME")
+	}
+	return home
+}
+
+// xdgConfigHome retorna $XDG_CONFIG_HOME o ~/.config
+func xdgConfigHome() string {
+	if xdgConfig := os.Getenv("XDG_CONFIG_HOME"); xdgConfig != "" {
+		return xdgConfig
+	}
+	return filepath.Join(userHome(), ".config")
+}
+
+// xdgDataHome retorna $XDG_DATA_HOME o ~/.local/share
+func xdgDataHome() string {
+	if xdgData := os.Getenv("XDG_DATA_HOME"); xdgData != "" {
+		return xdgData
+	}
+	return filepath.Join(userHome(), ".local", "share")
+}
+
+// isAnyBinaryInstalled verifica si alguno de los ejecutables está en PATH
+func isAnyBinaryInstalled(binaries []string) bool {
+	for _, b := range binaries {
+		if _, err := exec.LookPath(b); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// posixQuote cita un argumento como un shell POSIX (directivas shell de Kitty y command de Ghostty)
+func posixQuote(value string) string {
+	if !strings.ContainsAny(value, " \t'\"\\$") {
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", `'"'"'`) + "'"
+}
+
+// writeFragment respalda y escribe un fragmento generado por XEBEC
+func writeFragment(path, content string) error {
+	if err := validateBeforeWrite(path, content); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("error creando directorio %s: %w", filepath.Dir(path), err)
+	}
+	backupPath, err := BackupFile(path)
+	if err != nil {
+		return fmt.Errorf("error en backup: %w", err)
+	}
+	if backupPath != "" {
+		fmt.Printf("✓ Backup creado: %s\n", backupPath)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		return fmt.Errorf("error escribiendo configuración: %w", err)
+	}
+	trackWrite(path, content)
+	fmt.Printf("✓ Configuración aplicada: %s\n", path)
+	return nil
+}
+
+// upsertBlocks inyecta bloques gestionados en un archivo e informa del resultado
+func upsertBlocks(path string, blocks []ManagedBlock) error {
+	for _, block := range blocks {
+		result, err := UpsertBlockInFile(path, block.Component, block.Body)
+		if err != nil {
+			return err
+		}
+		if result.Drifted {
+			fmt.Printf("⚠ El bloque xebec:%s de %s tenía cambios manuales (backup: %s)\n", block.Component, result.Path, result.BackupPath)
+		}
+		if result.Changed {
+			fmt.Printf("✓ Bloque xebec:%s actualizado en %s\n", block.Component, result.Path)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,442 @@
+// Package: actions
+// Acciones de configuración de Starship
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// Opciones de configuración de Starship
+type StarshipConfigOptions struct {
+	Git         bool   // git_branch, git_status
+	Languages   bool   // golang, python, nodejs, rust
+	CmdDuration bool   // cmd_duration
+	OS          bool   // os
+	Time        bool   // time
+	Layout      string // "minimal", "two_line", "powerline"
+}
+
+// Layouts de prompt disponibles
+const (
+	StarshipLayoutMinimal   = "minimal"
+	StarshipLayoutTwoLine   = "two_line"
+	StarshipLayoutPowerline = "powerline"
+)
+
+// Glifos de Nerd Font (área de uso privado) del layout powerline
+const (
+	powerlineLeftRound = "\ue0b6" // Inicio redondeado del primer segmento
+	powerlineArrow     = "\ue0b0" // Separador entre segmentos y cierre
+)
+
+// GetStarshipConfigOptions retorna los módulos y layouts disponibles
+// Las opciones del grupo "layout" son excluyentes entre sí
+func GetStarshipConfigOptions() []ConfigOption {
+	return []ConfigOption{
+		{ID: "git", Title: "Git", Description: "Rama y estado del repositorio", Key: "git"},
+		{ID: "languages", Title: "Lenguajes", Description: "Go, Python, Node.js, Rust", Key: "languages"},
+		{ID: "cmd_duration", Title: "Duración", Description: "Tiempo de comandos lentos (> 2s)", Key: "cmd_duration"},
+		{ID: "os", Title: "Sistema", Description: "Icono del sistema operativo", Key: "os"},
+		{ID: "time", Title: "Hora", Description: "Hora actual (HH:MM)", Key: "time"},
+		{ID: "layout_minimal", Title: "Layout: Minimal", Description: "Una sola línea", Key: StarshipLayoutMinimal, Group: "layout"},
+		{ID: "layout_two_line", Title: "Layout: Dos líneas", Description: "Información arriba, prompt abajo", Key: StarshipLayoutTwoLine, Group: "layout"},
+		{ID: "layout_powerline", Title: "Layout: Powerline", Description: "Segmentos con fondo (requiere Nerd Font)", Key: StarshipLayoutPowerline, Group: "layout"},
+	}
+}
+
+// StarshipOptionsFromIDs construye las opciones a partir de los IDs marcados
+func StarshipOptionsFromIDs(ids []string) StarshipConfigOptions {
+	opts := StarshipConfigOptions{
+		Git:         containsID(ids, "git"),
+		Languages:   containsID(ids, "languages"),
+		CmdDuration: containsID(ids, "cmd_duration"),
+		OS:          containsID(ids, "os"),
+		Time:        containsID(ids, "time"),
+		Layout:      StarshipLayoutMinimal,
+	}
+	for _, opt := range GetStarshipConfigOptions() {
+		if opt.Group == "layout" && containsID(ids, opt.ID) {
+			opts.Layout = opt.Key
+		}
+	}
+	return opts
+}
+
+// modules retorna los módulos y el layout marcados por ID
+func (o StarshipConfigOptions) modules() map[string]bool {
+	return map[string]bool{
+		"git":                o.Git,
+		"languages":          o.Languages,
+		"cmd_duration":       o.CmdDuration,
+		"os":                 o.OS,
+		"time":               o.Time,
+		"layout_" + o.Layout: o.Layout != "",
+	}
+}
+
+// PaletteColor color con nombre para la paleta de Starship
+type PaletteColor struct {
+	Name string
+	Hex  string
+}
+
+// starshipLanguage módulo de lenguaje de Starship
+type starshipLanguage struct {
+	Module string
+	Symbol string
+}
+
+// StarshipTemplateData datos para renderizar starship.toml
+type StarshipTemplateData struct {
+	Layout    string
+	Format    string
+	Powerline bool
+	Palette   []PaletteColor
+	Modules   map[string]bool
+	Languages []starshipLanguage
+}
+
+var starshipLanguages = []starshipLanguage{
+	{Module: "golang", Symbol: "🐹 "},
+	{Module: "python", Symbol: "🐍 "},
+	{Module: "nodejs", Symbol: "⬢ "},
+	{Module: "rust", Symbol: "🦀 "},
+}
+
+// GetStarshipConfigPath retorna la ruta de starship.toml (respeta STARSHIP_CONFIG)
+func GetStarshipConfigPath() string {
+	if p := os.Getenv("STARSHIP_CONFIG"); p != "" {
+		return p
+	}
+	return filepath.Join(userHome(), ".config", "starship.toml")
+}
+
+// GetStarshipSourcePath retorna la ruta de la plantilla base de Starship
+func GetStarshipSourcePath() string {
+	_, currentFile, _, _ := runtime.Caller(0)
+	projectRoot := filepath.Dir(filepath.Dir(filepath.Dir(currentFile)))
+	return filepath.Join(projectRoot, "starship", "starship.toml")
+}
+
+// GetThemePalette retorna los acentos de UI del tema XEBEC ordenados por nombre
+func GetThemePalette() ([]PaletteColor, error) {
+	data, err := json.Marshal(XebecTheme().UI)
+	if err != nil {
+		return nil, fmt.Errorf("error leyendo colores del tema: %w", err)
+	}
+
+	var colors map[string]string
+	if err := json.Unmarshal(data, &colors); err != nil {
+		return nil, fmt.Errorf("error leyendo colores del tema: %w", err)
+	}
+
+	palette := make([]PaletteColor, 0, len(colors))
+	for name, hex := range colors {
+		palette = append(palette, PaletteColor{Name: name, Hex: hex})
+	}
+	sort.Slice(palette, func(i, j int) bool { return palette[i].Name < palette[j].Name })
+	return palette, nil
+}
+
+// starshipFormat construye el format del prompt según el layout y los módulos
+func starshipFormat(opts StarshipConfigOptions) string {
+	var location, vcs, langs, extras string
+	if opts.OS {
+		location += "$os"
+	}
+	location += "$directory"
+	if opts.Git {
+		vcs = "$git_branch$git_status"
+	}
+	if opts.Languages {
+		for _, l := range starshipLanguages {
+			langs += "$" + l.Module
+		}
+	}
+	if opts.CmdDuration {
+		extras += "$cmd_duration"
+	}
+	if opts.Time {
+		extras += "$time"
+	}
+
+	switch opts.Layout {
+	case StarshipLayoutTwoLine:
+		return "[┌─](bold primary)" + location + vcs + langs + extras + "\n[└─](bold primary)$character"
+	case StarshipLayoutPowerline:
+		segments := []struct{ content, color string }{
+			{location, "primary"},
+			{vcs, "accent_purple"},
+			{langs, "gray"},
+			{extras, "gray_dark"},
+		}
+		format := ""
+		prev := ""
+		for _, seg := range segments {
+			if seg.content == "" {
+				continue
+			}
+			if prev == "" {
+				format += fmt.Sprintf("[%s](%s)", powerlineLeftRound, seg.color)
+			} else {
+				format += fmt.Sprintf("[%s](fg:%s bg:%s)", powerlineArrow, prev, seg.color)
+			}
+			format += seg.content
+			prev = seg.color
+		}
+		return format + fmt.Sprintf("[%s ](fg:%s)", powerlineArrow, prev) + "\n$character"
+	default:
+		return location + vcs + langs + extras + "$character"
+	}
+}
+
+// RenderStarshipConfig genera starship.toml a partir de la plantilla y la paleta
+func RenderStarshipConfig(tmpl string, palette []PaletteColor, opts StarshipConfigOptions) (string, error) {
+	if opts.Layout == "" {
+		opts.Layout = StarshipLayoutMinimal
+	}
+	data := StarshipTemplateData{
+		Layout:    opts.Layout,
+		Format:    starshipFormat(opts),
+		Powerline: opts.Layout == StarshipLayoutPowerline,
+		Palette:   palette,
+		Modules: map[string]bool{
+			"git":          opts.Git,
+			"languages":    opts.Languages,
+			"cmd_duration": opts.CmdDuration,
+			"os":           opts.OS,
+			"time":         opts.Time,
+		},
+		Languages: starshipLanguages,
+	}
+	return renderTemplate("starship.toml", tmpl, data)
+}
+
+// ShellInitBlock bloque de inicialización a inyectar en un archivo de un shell
+type ShellInitBlock struct {
+	Path string
+	Body string
+}
+
+// starshipInitLine retorna la línea que inicializa Starship en un shell de una línea
+func starshipInitLine(shellID string) string {
+	switch shellID {
+	case "zsh":
+		return `eval "$(starship init zsh)"`
+	case "bash":
+		return `eval "$(starship init bash)"`
+	case "powershell":
+		return "Invoke-Expression (&starship init powershell)"
+	case "fish":
+		return "starship init fish | source"
+	}
+	return ""
+}
+
+// StarshipInitBlocks retorna los bloques de init de Starship para un shell
+// PowerShell recibe un bloque en el $PROFILE de cada edición instalada
+func StarshipInitBlocks(shell Shell) []ShellInitBlock {
+	switch shell.ID {
+	case "nu":
+		return []ShellInitBlock{
+			{Path: GetNushellEnvPath(), Body: nushellStarshipEnv()},
+			{Path: GetNushellConfigPath(), Body: nushellStarshipConfig()},
+		}
+	case "powershell":
+		var blocks []ShellInitBlock
+		for _, profile := range GetPowerShellProfiles() {
+			if profile.Installed {
+				blocks = append(blocks, ShellInitBlock{Path: profile.Path, Body: starshipInitLine(shell.ID)})
+			}
+		}
+		if len(blocks) > 0 {
+			return blocks
+		}
+	}
+	if line := starshipInitLine(shell.ID); line != "" {
+		return []ShellInitBlock{{Path: shell.RCPath, Body: line}}
+	}
+	return nil
+}
+
+// hasUserStarshipInit indica si un archivo inicializa Starship fuera del bloque xebec:starship
+// (p. ej. un eval "$(starship init bash)" escrito por el usuario)
+func hasUserStarshipInit(path string) (bool, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error leyendo %s: %w", path, err)
+	}
+	syntax := SyntaxForPath(path)
+	content, _ := syntax.Remove(string(data), "starship")
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, syntax.Comment) {
+			continue
+		}
+		if strings.Contains(line, "starship init") || strings.Contains(line, "starship/init.nu") {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// nushellStarshipInitPath retorna la ruta del script de init de Starship para Nushell
+func nushellStarshipInitPath() string {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		cacheDir = filepath.Join(userHome(), ".cache")
+	}
+	return filepath.Join(cacheDir, "starship", "init.nu")
+}
+
+// nushellStarshipEnv genera el script de init de Starship desde env.nu
+func nushellStarshipEnv() string {
+	return strings.Join([]string{
+		"if (which starship | is-not-empty) {",
+		fmt.Sprintf("  starship init nu | save -f '%s'", nushellStarshipInitPath()),
+		"}",
+	}, "\n")
+}
+
+// nushellStarshipConfig carga el script de init de Starship desde config.nu
+func nushellStarshipConfig() string {
+	return fmt.Sprintf("use '%s'", nushellStarshipInitPath())
+}
+
+// ConfigureStarship genera starship.toml e inicializa Starship en cada shell detectado
+func ConfigureStarship(opts StarshipConfigOptions) (err error) {
+	defer recordConfigured("starship", opts.modules(), &err)
+
+	// Verificar que Starship esté instalado
+	if !IsStarshipInstalled() {
+		return fmt.Errorf("Starship no está instalado en el sistema")
+	}
+
+	// Generar starship.toml
+	tmpl, err := os.ReadFile(GetStarshipSourcePath())
+	if err != nil {
+		return fmt.Errorf("error leyendo plantilla starship.toml: %w", err)
+	}
+	palette, err := GetThemePalette()
+	if err != nil {
+		return err
+	}
+	content, err := RenderStarshipConfig(string(tmpl), palette, opts)
+	if err != nil {
+		return fmt.Errorf("error generando configuración: %w", err)
+	}
+
+	destPath := GetStarshipConfigPath()
+	if err := validateBeforeWrite(destPath, content); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(destPath), 0755); err != nil {
+		return fmt.Errorf("error creando directorio %s: %w", filepath.Dir(destPath), err)
+	}
+	backupPath, err := BackupFile(destPath)
+	if err != nil {
+		return fmt.Errorf("error en backup: %w", err)
+	}
+	if backupPath != "" {
+		fmt.Printf("✓ Backup creado: %s\n", backupPath)
+	}
+	if err := os.WriteFile(destPath, []byte(content), 0644); err != nil {
+		return fmt.Errorf("error escribiendo configuración: %w", err)
+	}
+	trackWrite(destPath, content)
+	fmt.Printf("✓ Configuración aplicada: %s\n", destPath)
+
+	// Inicializar en cada shell detectado
+	return WireStarshipInit(DetectShells())
+}
+
+// WireStarshipInit inyecta el init de Starship en los shells dados (idempotente)
+func WireStarshipInit(shells []Shell) error {
+	if len(shells) == 0 {
+		fmt.Println("⚠ No se detectó ningún shell compatible")
+		return nil
+	}
+
+	for _, shell := range shells {
+		// Un init propio del usuario se respeta: otro bloque cargaría Starship dos veces
+		path, err := userStarshipInitPath(shell)
+		if err != nil {
+			return err
+		}
+		if path != "" {
+			fmt.Printf("• %s ya inicializa Starship por su cuenta (%s)\n", shell.Name, path)
+			continue
+		}
+
+		if shell.ID == "nu" {
+			// `use` exige que el script exista al parsear config.nu
+			if err := ensureFile(nushellStarshipInitPath()); err != nil {
+				return err
+			}
+		}
+
+		var changed []string
+		for _, block := range StarshipInitBlocks(shell) {
+			result, err := UpsertBlockInFile(block.Path, "starship", block.Body)
+			if err != nil {
+				return fmt.Errorf("error inicializando Starship en %s: %w", shell.Name, err)
+			}
+			if result.Drifted {
+				fmt.Printf("⚠ El bloque xebec:starship de %s tenía cambios manuales (backup: %s)\n", result.Path, result.BackupPath)
+			}
+			if result.Changed {
+				changed = append(changed, block.Path)
+			}
+		}
+
+		if len(changed) > 0 {
+			fmt.Printf("✓ Starship inicializado en %s (%s)\n", shell.Name, strings.Join(changed, ", "))
+		} else {
+			fmt.Printf("• %s ya inicializa Starship\n", shell.Name)
+		}
+	}
+	return nil
+}
+
+// userStarshipInitPath retorna el archivo del shell que ya inicializa Starship sin XEBEC, o ""
+func userStarshipInitPath(shell Shell) (string, error) {
+	for _, block := range StarshipInitBlocks(shell) {
+		found, err := hasUserStarshipInit(block.Path)
+		if err != nil {
+			return "", err
+		}
+		if found {
+			return block.Path, nil
+		}
+	}
+	return "", nil
+}
+
+// IsStarshipInstalled verifica si Starship está instalado
+func IsStarshipInstalled() bool {
+	return isAnyBinaryInstalled([]string{"starship"})
+}
+
+// GetStarshipStatus retorna el estado actual de Starship
+func GetStarshipStatus() (installed bool, configured bool, configPath string) {
+	configPath = GetStarshipConfigPath()
+	installed = IsStarshipInstalled()
+
+	if _, err := os.Stat(configPath); err == nil {
+		configured = true
+	}
+
+	return
+}
@@ -0,0 +1,128 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestRenderStarshipConfigNerdFontGlyphs(t *testing.T) {
+	tmpl, err := os.ReadFile("../../starship/starship.toml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	palette := []PaletteColor{{Name: "primary", Hex: "#66D9EF"}}
+
+	tests := []struct {
+		name   string
+		opts   StarshipConfigOptions
+		want   []string
+		absent []string
+	}{
+		{
+			name: "powerline",
+			opts: StarshipConfigOptions{Git: true, Time: true, Layout: StarshipLayoutPowerline},
+			want: []string{
+				"[\ue0b6](primary)",
+				"[\ue0b0](fg:primary bg:accent_purple)",
+				"[\ue0b0](fg:accent_purple bg:gray_dark)",
+				"[\ue0b0 ](fg:gray_dark)",
+				"symbol = \"\ue0a0 \"",
+			},
+		},
+		{
+			name:   "minimal",
+			opts:   StarshipConfigOptions{Git: true, Layout: StarshipLayoutMinimal},
+			want:   []string{"symbol = \"\ue0a0 \""},
+			absent: []string{"\ue0b0", "\ue0b6"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := RenderStarshipConfig(string(tmpl), palette, tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(out, want) {
+					t.Errorf("falta %q en:\n%s", want, out)
+				}
+			}
+			for _, absent := range tt.absent {
+				if strings.Contains(out, absent) {
+					t.Errorf("no debería contener %q", absent)
+				}
+			}
+		})
+	}
+}
+
+func TestWireStarshipInitPowerShellProfile(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("el pwsh falso es un script sh")
+	}
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(dir, ".config"))
+	t.Setenv("XDG_DATA_HOME", filepath.Join(dir, "data"))
+
+	// pwsh responde con un $PROFILE redirigido (Documentos en OneDrive)
+	profile := filepath.Join(dir, "OneDrive", "Documents", "PowerShell", "Microsoft.PowerShell_profile.ps1")
+	bin := t.TempDir()
+	script := "#!/bin/sh\necho '" + profile + "'\n"
+	if err := os.WriteFile(filepath.Join(bin, "pwsh"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", bin)
+
+	shell := Shell{ID: "powershell", Name: "PowerShell", RCPath: GetPowerShellProfilePath()}
+	if err := WireStarshipInit([]Shell{shell}); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(profile)
+	if err != nil {
+		t.Fatalf("no se escribió el $PROFILE consultado: %v", err)
+	}
+	if !strings.Contains(string(data), "Invoke-Expression (&starship init powershell)") {
+		t.Errorf("falta el init de Starship en:\n%s", data)
+	}
+	if _, err := os.Stat(shell.RCPath); err == nil {
+		t.Errorf("se escribió también la ruta por defecto %s", shell.RCPath)
+	}
+}
+
+func TestWireStarshipInitKeepsUserInit(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_DATA_HOME", filepath.Join(dir, "data"))
+
+	rc := filepath.Join(dir, ".bashrc")
+	user := "# prompt\neval \"$(starship init bash)\"\n"
+	if err := os.WriteFile(rc, []byte(user), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := WireStarshipInit([]Shell{{ID: "bash", Name: "Bash", RCPath: rc}}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != user {
+		t.Errorf("se añadió un segundo init de Starship:\n%s", data)
+	}
+
+	// Un init comentado no cuenta
+	if err := os.WriteFile(rc, []byte("# eval \"$(starship init bash)\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := WireStarshipInit([]Shell{{ID: "bash", Name: "Bash", RCPath: rc}}); err != nil {
+		t.Fatal(err)
+	}
+	if status, _ := CheckBlockInFile(rc, "starship"); status != BlockInSync {
+		t.Errorf("bloque xebec:starship = %s, want %s", status, BlockInSync)
+	}
+}
@@ -7,13 +7,12 @@ package actions
 
 import (
 	"fmt"
-	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
-	"time"
 )
 
 // Opciones de configuración de Alacritty
@@ -26,15 +25,16 @@ type AlacrittyConfigOptions struct {
 }
 
 // AlacrittyConfigOption representa una opción en el menú de checkboxes
-type AlacrittyConfigOption struct {
-	ID          string
-	Title       string
-	Description string
-	Key         string // "window", "colors", "font", "cursor", "shell"
-}
+// Key: "window", "colors", "font", "cursor", "shell"
+type AlacrittyConfigOption = ConfigOption
 
 // GetAlacrittyConfigOptions retorna las opciones disponibles para configurar
+// Si JetBrains Mono no está instalada la opción de fuente lo indica
 func GetAlacrittyConfigOptions() []AlacrittyConfigOption {
+	fontDescription := "JetBrains Mono, tamaño"
+	if !IsXebecFontInstalled() {
+		fontDescription += " ⚠ no instalada: xebec install font"
+	}
 	return []AlacrittyConfigOption{
 		{
 			ID:          "window",
@@ -51,7 +51,7 @@ func GetAlacrittyConfigOptions() []AlacrittyConfigOption {
 		{
 			ID:          "font",
 			Title:       "Fuente",
-			Description: "JetBrains Mono, tamaño",
+			Description: fontDescription,
 			Key:         "font",
 		},
 		{
@@ -69,6 +69,28 @@ func GetAlacrittyConfigOptions() []AlacrittyConfigOption {
 	}
 }
 
+// AlacrittyOptionsFromIDs construye las opciones a partir de los IDs marcados
+func AlacrittyOptionsFromIDs(ids []string) AlacrittyConfigOptions {
+	return AlacrittyConfigOptions{
+		Window: containsID(ids, "window"),
+		Colors: containsID(ids, "colors"),
+		Font:   containsID(ids, "font"),
+		Cursor: containsID(ids, "cursor"),
+		Shell:  containsID(ids, "shell"),
+	}
+}
+
+// modules retorna las opciones marcadas por ID
+func (o AlacrittyConfigOptions) modules() map[string]bool {
+	return map[string]bool{
+		"window": o.Window,
+		"colors": o.Colors,
+		"font":   o.Font,
+		"cursor": o.Cursor,
+		"shell":  o.Shell,
+	}
+}
+
 // GetAlacrittyConfigPath retorna la ruta de configuración según el SO
 func GetAlacrittyConfigPath() string {
 	switch runtime.GOOS {
@@ -115,40 +137,76 @@ func EnsureAlacrittyDir() error {
 
 // BackupAlacrittyConfig hace un backup del archivo de configuración existente
 func BackupAlacrittyConfig() (string, error) {
-	configPath := GetAlacrittyConfigPath()
-
-	if _, err := os.Stat(configPath); os.IsNotExist(err) {
-		return "", nil // No hay archivo existente
-	}
+	return BackupFile(GetAlacrittyConfigPath())
+}
 
-	// Crear directorio de backups
-	backupDir := filepath.Join(GetAlacrittyConfigDir(), "backups")
-	if err := os.MkdirAll(backupDir, 0755); err != nil {
-		return "", fmt.Errorf("error creando directorio de backups: %w", err)
+// alacrittyShellTable tabla del shell que entiende la Alacritty instalada
+// [terminal.shell] desde 0.14 y [shell] antes; sin versión conocida, la actual
+func alacrittyShellTable() string {
+	if version := installedToolVersion("alacritty"); version != "" && !versionAtLeast(version, "0.14") {
+		return "shell"
 	}
+	return "terminal.shell"
+}
 
-	// Nombre del backup con timestamp
-	timestamp := time.Now().Format("2006-01-02_15-04-05")
-	backupPath := filepath.Join(backupDir, fmt.Sprintf("alacritty_%s.toml", timestamp))
+// SetAlacrittyShell fija el program del shell en el contenido de alacritty.toml
+// Edita [terminal.shell] o el antiguo [shell] donde estén; si no hay ninguno añade table
+// Los args del shell anterior se eliminan porque dependen del programa
+func SetAlacrittyShell(content, program, table string) string {
+	eol, lines := splitContent(content)
+	programLine := "program = " + tomlString(program)
+	isShell := func(section string) bool { return section == "terminal.shell" || section == "shell" }
+
+	var out []string
+	section := ""
+	written := false
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "[") {
+			if isShell(section) && !written {
+				out = append(out, programLine)
+				written = true
+			}
+			section = strings.Trim(trimmed, "[] ")
+			out = append(out, line)
+			continue
+		}
 
-	// Copiar archivo
-	src, err := os.Open(configPath)
-	if err != nil {
-		return "", fmt.Errorf("error abriendo archivo original: %w", err)
+		key := strings.TrimSpace(strings.SplitN(trimmed, "=", 2)[0])
+		switch {
+		case isShell(section) && key == "program":
+			if !written {
+				out = append(out, programLine)
+				written = true
+			}
+		case isShell(section) && key == "args":
+		case (section == "terminal" || section == "") && key == "shell":
+			out = append(out, "shell = { program = "+tomlString(program)+" }")
+			written = true
+		default:
+			out = append(out, line)
+		}
 	}
-	defer src.Close()
 
-	dst, err := os.Create(backupPath)
-	if err != nil {
-		return "", fmt.Errorf("error creando backup: %w", err)
+	if !written {
+		if isShell(section) {
+			out = append(out, programLine)
+		} else {
+			if len(out) > 0 && strings.TrimSpace(out[len(out)-1]) != "" {
+				out = append(out, "")
+			}
+			out = append(out, "["+table+"]", programLine)
+		}
 	}
-	defer dst.Close()
+	return joinContent(out, eol)
+}
 
-	if _, err := io.Copy(dst, src); err != nil {
-		return "", fmt.Errorf("error copiando backup: %w", err)
+// tomlString escribe un string TOML; usa comillas simples para rutas de Windows
+func tomlString(value string) string {
+	if !strings.Contains(value, "'") {
+		return "'" + value + "'"
 	}
-
-	return backupPath, nil
+	return strconv.Quote(value)
 }
 
 // GetSourceConfigPath retorna la ruta del archivo de configuración base
@@ -159,8 +217,30 @@ func GetSourceConfigPath() string {
 	return filepath.Join(projectRoot, "alacritty", "alacritty.toml")
 }
 
+// AlacrittyTemplateData datos para renderizar alacritty/alacritty.toml
+type AlacrittyTemplateData struct {
+	Palette TerminalPalette
+}
+
+// RenderAlacrittyConfig renderiza la configuración base con una paleta y la filtra según las opciones
+func RenderAlacrittyConfig(palette TerminalPalette, opts AlacrittyConfigOptions) (string, error) {
+	sourcePath := GetSourceConfigPath()
+	sourceData, err := os.ReadFile(sourcePath)
+	if err != nil {
+		return "", fmt.Errorf("error leyendo configuración base: %w", err)
+	}
+
+	rendered, err := renderTemplate("alacritty", string(sourceData), AlacrittyTemplateData{Palette: palette})
+	if err != nil {
+		return "", err
+	}
+	return filterConfigByOptions(rendered, opts), nil
+}
+
 // ConfigureAlacritty aplica la configuración de Alacritty según las opciones seleccionadas
-func ConfigureAlacritty(opts AlacrittyConfigOptions) error {
+func ConfigureAlacritty(opts AlacrittyConfigOptions) (err error) {
+	defer recordConfigured("alacritty", opts.modules(), &err)
+
 	// Verificar que Alacritty esté instalado
 	if !IsAlacrittyInstalled() {
 		return fmt.Errorf("Alacritty no está instalado en el sistema")
@@ -171,6 +251,22 @@ func ConfigureAlacritty(opts AlacrittyConfigOptions) error {
 		return fmt.Errorf("error asegurando directorio: %w", err)
 	}
 
+	// Renderizar la configuración base con la paleta XEBEC y filtrar según opciones
+	configContent, err := RenderAlacrittyConfig(XebecPalette(), opts)
+	if err != nil {
+		return err
+	}
+	// Alacritty anterior a 0.14 no entiende [terminal.shell]
+	if table := alacrittyShellTable(); table != "terminal.shell" {
+		configContent = strings.Replace(configContent, "[terminal.shell]", "["+table+"]", 1)
+	}
+
+	// Validar contra el esquema de la versión instalada antes de tocar nada
+	destPath := GetAlacrittyConfigPath()
+	if err := validateBeforeWrite(destPath, configContent); err != nil {
+		return err
+	}
+
 	// Hacer backup si existe configuración
 	backupPath, err := BackupAlacrittyConfig()
 	if err != nil {
@@ -180,23 +276,16 @@ func ConfigureAlacritty(opts AlacrittyConfigOptions) error {
 		fmt.Printf("✓ Backup creado: %s\n", backupPath)
 	}
 
-	// Leer configuración base
-	sourcePath := GetSourceConfigPath()
-	sourceData, err := os.ReadFile(sourcePath)
-	if err != nil {
-		return fmt.Errorf("error leyendo configuración base: %w", err)
-	}
-
-	// Filtrar según opciones seleccionadas
-	configContent := filterConfigByOptions(string(sourceData), opts)
-
 	// Escribir configuración
-	destPath := GetAlacrittyConfigPath()
 	if err := os.WriteFile(destPath, []byte(configContent), 0644); err != nil {
 		return fmt.Errorf("error escribiendo configuración: %w", err)
 	}
+	trackWrite(destPath, configContent)
 
 	fmt.Printf("✓ Configuración aplicada: %s\n", destPath)
+	if opts.Font {
+		warnMissingFont("Alacritty")
+	}
 	return nil
 }
 
@@ -251,6 +340,11 @@ func filterConfigByOptions(content string, opts AlacrittyConfigOptions) string {
 			}
 		}
 
+		// Sin líneas en blanco seguidas (quedan al omitir secciones)
+		if trimmed == "" && len(result) > 0 && strings.TrimSpace(result[len(result)-1]) == "" {
+			continue
+		}
+
 		// Incluir línea si estamos en una sección válida
 		if includeSection || trimmed == "" || strings.HasPrefix(trimmed, "#") {
 			result = append(result, line)
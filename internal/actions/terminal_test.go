@@ -0,0 +1,51 @@
+package actions
+
+import "testing"
+
+func TestSetAlacrittyShell(t *testing.T) {
+	tests := []struct {
+		name, content, table, want string
+	}{
+		{
+			"terminal.shell",
+			"[terminal.shell]\nprogram = 'bash'\nargs = ['-l']\n\n[font]\nsize = 13\n",
+			"terminal.shell",
+			"[terminal.shell]\nprogram = '/usr/bin/nu'\n\n[font]\nsize = 13\n",
+		},
+		{
+			"shell antiguo se edita en su sitio",
+			"[shell]\nprogram = 'bash'\n",
+			"terminal.shell",
+			"[shell]\nprogram = '/usr/bin/nu'\n",
+		},
+		{
+			"sin shell en 0.13",
+			"[font]\nsize = 13\n",
+			"shell",
+			"[font]\nsize = 13\n\n[shell]\nprogram = '/usr/bin/nu'\n",
+		},
+		{
+			"tabla en línea",
+			"[terminal]\nshell = { program = 'bash' }\n",
+			"terminal.shell",
+			"[terminal]\nshell = { program = '/usr/bin/nu' }\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SetAlacrittyShell(tt.content, "/usr/bin/nu", tt.table); got != tt.want {
+				t.Errorf("SetAlacrittyShell() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlacrittyShellTable(t *testing.T) {
+	for version, want := range map[string]string{"0.13.2": "shell", "0.14.0": "terminal.shell", "": "terminal.shell"} {
+		toolVersions["alacritty"] = version
+		if got := alacrittyShellTable(); got != want {
+			t.Errorf("alacrittyShellTable() con %q = %s, want %s", version, got, want)
+		}
+	}
+	delete(toolVersions, "alacritty")
+}
@@ -0,0 +1,23 @@
+# ============================================
+#  KONSOLE – XEBEC CORPORATION
+#  Generado por xebec
+# ============================================
+
+[General]
+Name=XEBEC
+Parent=FALLBACK/
+TerminalMargin=0
+Command=/usr/bin/nu --login
+
+[Appearance]
+ColorScheme=XEBEC
+Font=JetBrains Mono,13,-1,5,50,0,0,0,0,0
+
+[Scrolling]
+ScrollBarPosition=2
+
+[Cursor Options]
+CursorShape=1
+
+[Terminal Features]
+BlinkingCursorEnabled=true
@@ -0,0 +1,60 @@
+-- ============================================
+--  WEZTERM – XEBEC CORPORATION
+--  Generado por xebec: /home/xebec/.config/wezterm/xebec.lua
+-- ============================================
+
+local wezterm = require 'wezterm'
+local M = {}
+
+function M.apply_to_config(config)
+  config.colors = config.colors or {}
+
+  -- Ventana sin bordes, transparente y maximizada
+  config.window_decorations = 'RESIZE'
+  config.window_background_opacity = 0.85
+  config.window_padding = { left = 0, right = 0, top = 0, bottom = 0 }
+  wezterm.on('gui-startup', function(cmd)
+    local _, _, window = wezterm.mux.spawn_window(cmd or {})
+    window:gui_window():maximize()
+  end)
+
+  -- Tema XEBEC
+  config.colors.foreground = '#E6E6E6'
+  config.colors.background = '#000000'
+  config.colors.cursor_bg = '#00AEEF'
+  config.colors.cursor_fg = '#000000'
+  config.colors.cursor_border = '#00AEEF'
+  config.colors.selection_bg = '#1A1A1A'
+  config.colors.selection_fg = '#E6E6E6'
+  config.colors.ansi = { '#0A0A0A', '#FF4C4C', '#4CAF50', '#FFC107', '#00AEEF', '#9C27B0', '#26C6DA', '#E6E6E6' }
+  config.colors.brights = { '#4A4A4A', '#FF6B6B', '#81C784', '#FFD54F', '#29B6F6', '#BA68C8', '#4DD0E1', '#FFFFFF' }
+
+  -- Fuente
+  config.font = wezterm.font_with_fallback { 'JetBrains Mono', 'Symbols Nerd Font Mono' }
+  config.font_size = 13.0
+
+  -- Cursor
+  config.default_cursor_style = 'BlinkingBar'
+  config.cursor_blink_rate = 500
+
+  -- Shell
+  config.default_prog = { '/usr/bin/nu', '--login' }
+
+  -- Barra de pestañas
+  config.use_fancy_tab_bar = false
+  config.tab_bar_at_bottom = true
+  config.hide_tab_bar_if_only_one_tab = true
+  config.tab_max_width = 32
+  config.colors.tab_bar = {
+    background = '#000000',
+    active_tab = { bg_color = '#00AEEF', fg_color = '#000000', intensity = 'Bold' },
+    inactive_tab = { bg_color = '#0A0A0A', fg_color = '#4A4A4A' },
+    inactive_tab_hover = { bg_color = '#1A1A1A', fg_color = '#E6E6E6' },
+    new_tab = { bg_color = '#000000', fg_color = '#4A4A4A' },
+    new_tab_hover = { bg_color = '#1A1A1A', fg_color = '#00AEEF' },
+  }
+
+  return config
+end
+
+return M
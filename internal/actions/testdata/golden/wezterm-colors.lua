@@ -0,0 +1,26 @@
+-- ============================================
+--  WEZTERM – XEBEC CORPORATION
+--  Generado por xebec: /home/xebec/.config/wezterm/xebec.lua
+-- ============================================
+
+local wezterm = require 'wezterm'
+local M = {}
+
+function M.apply_to_config(config)
+  config.colors = config.colors or {}
+
+  -- Tema XEBEC
+  config.colors.foreground = '#E6E6E6'
+  config.colors.background = '#000000'
+  config.colors.cursor_bg = '#00AEEF'
+  config.colors.cursor_fg = '#000000'
+  config.colors.cursor_border = '#00AEEF'
+  config.colors.selection_bg = '#1A1A1A'
+  config.colors.selection_fg = '#E6E6E6'
+  config.colors.ansi = { '#0A0A0A', '#FF4C4C', '#4CAF50', '#FFC107', '#00AEEF', '#9C27B0', '#26C6DA', '#E6E6E6' }
+  config.colors.brights = { '#4A4A4A', '#FF6B6B', '#81C784', '#FFD54F', '#29B6F6', '#BA68C8', '#4DD0E1', '#FFFFFF' }
+
+  return config
+end
+
+return M
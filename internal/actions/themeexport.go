@@ -0,0 +1,182 @@
+// Package: actions
+// Exportadores del tema canónico a los formatos de terminales y herramientas
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// ThemeExporter genera un tema en el formato de una herramienta
+type ThemeExporter struct {
+	ID          string
+	Title       string
+	Description string
+	Export      func(Theme) (string, error)
+}
+
+// ThemeExportData datos comunes para renderizar las plantillas de colores
+// Reúne los campos que usan las plantillas de todos los terminales; solo se activa el módulo colors
+type ThemeExportData struct {
+	Modules    map[string]bool
+	Palette    TerminalPalette
+	Variant    string
+	Colors     []string // 16 colores ANSI
+	Scopes     []tmThemeScope
+	UUID       string
+	ConfPath   string
+	ModulePath string
+	Program    string
+	Command    string
+	Shell      string
+	Args       []string
+}
+
+// tmThemeScope regla de resaltado de un .tmTheme (bat)
+type tmThemeScope struct {
+	Name       string
+	Scope      string
+	Foreground string
+	FontStyle  string
+}
+
+// newThemeExportData construye los datos de plantilla de un tema con solo el módulo de colores
+func newThemeExportData(theme Theme) ThemeExportData {
+	p := theme.TerminalPalette
+	return ThemeExportData{
+		Modules: map[string]bool{"colors": true},
+		Palette: p,
+		Variant: theme.Variant,
+		Colors:  append(p.Normal.List(), p.Bright.List()...),
+		UUID:    XebecGnomeProfileUUID,
+		Scopes: []tmThemeScope{
+			{Name: "Comment", Scope: "comment", Foreground: p.Bright.Black, FontStyle: "italic"},
+			{Name: "String", Scope: "string", Foreground: p.Normal.Green},
+			{Name: "Number", Scope: "constant.numeric", Foreground: p.Bright.Magenta},
+			{Name: "Constant", Scope: "constant.language, constant.character", Foreground: p.Bright.Cyan},
+			{Name: "Keyword", Scope: "keyword, storage", Foreground: p.Normal.Blue, FontStyle: "bold"},
+			{Name: "Operator", Scope: "keyword.operator", Foreground: p.Normal.Yellow},
+			{Name: "Function", Scope: "entity.name.function, support.function", Foreground: p.Normal.Cyan},
+			{Name: "Type", Scope: "entity.name.type, entity.name.class, support.type", Foreground: p.Bright.Yellow},
+			{Name: "Variable", Scope: "variable, variable.parameter", Foreground: p.Foreground},
+			{Name: "Tag", Scope: "entity.name.tag", Foreground: p.Bright.Blue},
+			{Name: "Attribute", Scope: "entity.other.attribute-name", Foreground: p.Bright.Magenta},
+			{Name: "Invalid", Scope: "invalid", Foreground: p.Bright.Red, FontStyle: "underline"},
+			{Name: "Heading", Scope: "markup.heading", Foreground: p.Normal.Blue, FontStyle: "bold"},
+			{Name: "Inserted", Scope: "markup.inserted", Foreground: p.Normal.Green},
+			{Name: "Deleted", Scope: "markup.deleted", Foreground: p.Normal.Red},
+			{Name: "Changed", Scope: "markup.changed", Foreground: p.Normal.Yellow},
+		},
+	}
+}
+
+// GetThemeTemplatesRoot retorna la raíz del proyecto donde viven las plantillas
+func GetThemeTemplatesRoot() string {
+	_, currentFile, _, _ := runtime.Caller(0)
+	return filepath.Dir(filepath.Dir(filepath.Dir(currentFile)))
+}
+
+// templateExporter exporta renderizando una plantilla del proyecto (dir/name) con el tema
+func templateExporter(dir, name string) func(Theme) (string, error) {
+	return func(theme Theme) (string, error) {
+		tmpl, err := os.ReadFile(filepath.Join(GetThemeTemplatesRoot(), dir, name))
+		if err != nil {
+			return "", fmt.Errorf("error leyendo plantilla %s: %w", name, err)
+		}
+		return renderTemplate(name, string(tmpl), newThemeExportData(theme))
+	}
+}
+
+// GetThemeExporters retorna los formatos de exportación disponibles
+func GetThemeExporters() []ThemeExporter {
+	return []ThemeExporter{
+		{ID: "alacritty", Title: "Alacritty", Description: "Secciones [colors] de alacritty.toml", Export: func(t Theme) (string, error) {
+			return RenderAlacrittyConfig(t.TerminalPalette, AlacrittyConfigOptions{Colors: true})
+		}},
+		{ID: "kitty", Title: "Kitty", Description: "Archivo para include en kitty.conf", Export: templateExporter("kitty", "xebec.conf")},
+		{ID: "wezterm", Title: "WezTerm", Description: "Módulo Lua con apply_to_config", Export: templateExporter("wezterm", "xebec.lua")},
+		{ID: "ghostty", Title: "Ghostty", Description: "Archivo para config-file", Export: templateExporter("ghostty", "xebec")},
+		{ID: "windows-terminal", Title: "Windows Terminal", Description: "Objeto para \"schemes\" de settings.json", Export: exportWindowsTerminalScheme},
+		{ID: "gnome", Title: "GNOME Terminal", Description: "Perfil para dconf load", Export: templateExporter("gnome-terminal", "xebec.dconf")},
+		{ID: "konsole", Title: "Konsole", Description: "Archivo .colorscheme", Export: templateExporter("konsole", "XEBEC.colorscheme")},
+		{ID: "xfce", Title: "XFCE Terminal", Description: "Esquema .theme de xfce4-terminal", Export: templateExporter("xfce4-terminal", "xebec.theme")},
+		{ID: "foot", Title: "foot", Description: "Secciones [colors] y [cursor] de foot.ini", Export: templateExporter("foot", "foot.ini")},
+		{ID: "rio", Title: "Rio", Description: "Tema para ~/.config/rio/themes", Export: templateExporter("rio", "xebec.toml")},
+		{ID: "tilix", Title: "Tilix", Description: "Esquema JSON de ~/.config/tilix/schemes", Export: templateExporter("tilix", "xebec.json")},
+		{ID: "xresources", Title: "X resources", Description: "Recursos para xrdb -merge", Export: templateExporter("xresources", "xebec.Xresources")},
+		{ID: "ls_colors", Title: "LS_COLORS", Description: "Valor de LS_COLORS en truecolor", Export: func(t Theme) (string, error) {
+			return LSColorsFor(t.TerminalPalette) + "\n", nil
+		}},
+		{ID: "fzf", Title: "fzf", Description: "Opción --color para FZF_DEFAULT_OPTS", Export: func(t Theme) (string, error) {
+			return "--color=" + FzfColorsFor(t.TerminalPalette) + "\n", nil
+		}},
+		{ID: "bat", Title: "bat", Description: "Tema .tmTheme (también usado por delta)", Export: templateExporter("bat", "xebec.tmTheme")},
+		{ID: "delta", Title: "delta", Description: "Sección [delta] de .gitconfig", Export: templateExporter("delta", "xebec.gitconfig")},
+	}
+}
+
+// GetThemeExportFormats retorna los IDs de los formatos de exportación
+func GetThemeExportFormats() []string {
+	exporters := GetThemeExporters()
+	ids := make([]string, 0, len(exporters))
+	for _, e := range exporters {
+		ids = append(ids, e.ID)
+	}
+	return ids
+}
+
+// ExportTheme genera el tema en el formato indicado
+func ExportTheme(theme Theme, format string) (string, error) {
+	for _, e := range GetThemeExporters() {
+		if e.ID == format {
+			return e.Export(theme)
+		}
+	}
+	return "", fmt.Errorf("formato desconocido: %s (disponibles: %s)", format, strings.Join(GetThemeExportFormats(), ", "))
+}
+
+// exportWindowsTerminalScheme genera el esquema de colores de Windows Terminal
+func exportWindowsTerminalScheme(theme Theme) (string, error) {
+	data, err := json.MarshalIndent(newWTScheme(theme.TerminalPalette), "", "    ")
+	if err != nil {
+		return "", fmt.Errorf("error generando esquema: %w", err)
+	}
+	return string(data) + "\n", nil
+}
+
+// mixHex mezcla el color a con b; weight es la proporción de a (0-1)
+func mixHex(a, b string, weight float64) string {
+	ca, cb := hexComponents(a), hexComponents(b)
+	var out strings.Builder
+	out.WriteByte('#')
+	for i := 0; i < 3; i++ {
+		v := float64(ca[i])*weight + float64(cb[i])*(1-weight)
+		fmt.Fprintf(&out, "%02X", int(v+0.5))
+	}
+	return out.String()
+}
+
+// hexComponents retorna R, G y B de un color #RRGGBB (0 si no es válido)
+func hexComponents(hex string) [3]uint8 {
+	var rgb [3]uint8
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) != 6 {
+		return rgb
+	}
+	for i := 0; i < 3; i++ {
+		v, err := strconv.ParseUint(hex[i*2:i*2+2], 16, 8)
+		if err != nil {
+			return [3]uint8{}
+		}
+		rgb[i] = uint8(v)
+	}
+	return rgb
+}
@@ -0,0 +1,253 @@
+package actions
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// exportTestTheme tema con colores distintos de XEBEC para que nada salga de la paleta por defecto
+func exportTestTheme(t *testing.T) Theme {
+	t.Helper()
+	theme, err := NewTheme(TerminalPalette{
+		Name: "Prueba", Background: "#101820", Foreground: "#D0D8E0", Cursor: "#F0A030", Selection: "#304050",
+		Normal: ANSIColors{Black: "#202020", Red: "#C03030", Green: "#30A040", Yellow: "#C0A020", Blue: "#3060C0", Magenta: "#9040A0", Cyan: "#30A0A0", White: "#C0C0C0"},
+		Bright: ANSIColors{Black: "#606060", Red: "#E05050", Green: "#50C060", Yellow: "#E0C040", Blue: "#5080E0", Magenta: "#B060C0", Cyan: "#50C0C0", White: "#F0F0F0"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return theme
+}
+
+// iniValues indexa las claves de un INI como "sección.clave"
+func iniValues(content string) map[string]string {
+	values := map[string]string{}
+	for _, k := range ParseINI(content) {
+		values[k.Section+"."+k.Key] = k.Value
+	}
+	return values
+}
+
+// lineValues indexa líneas "clave<sep>valor" (kitty, ghostty, Xresources)
+func lineValues(content, sep string) map[string][]string {
+	values := map[string][]string{}
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+			continue
+		}
+		if k, v, ok := strings.Cut(line, sep); ok {
+			key := strings.TrimSpace(k)
+			values[key] = append(values[key], strings.TrimSpace(v))
+		}
+	}
+	return values
+}
+
+// samePalette compara fondo, texto y los 16 colores ANSI
+func samePalette(t *testing.T, got, want TerminalPalette) {
+	t.Helper()
+	if got.Background != want.Background || got.Foreground != want.Foreground {
+		t.Errorf("fondo/texto = %s/%s, want %s/%s", got.Background, got.Foreground, want.Background, want.Foreground)
+	}
+	if got.Normal != want.Normal || got.Bright != want.Bright {
+		t.Errorf("colores ANSI = %v %v, want %v %v", got.Normal, got.Bright, want.Normal, want.Bright)
+	}
+}
+
+func expectValue(t *testing.T, values map[string]string, key, want string) {
+	t.Helper()
+	if got := values[key]; got != want {
+		t.Errorf("%s = %q, want %q", key, got, want)
+	}
+}
+
+func TestExportTheme(t *testing.T) {
+	theme := exportTestTheme(t)
+	p := theme.TerminalPalette
+	colors := append(p.Normal.List(), p.Bright.List()...)
+
+	// Cada exportador se vuelve a leer con el parser de su formato
+	checks := map[string]func(t *testing.T, out string){
+		"alacritty": func(t *testing.T, out string) {
+			got, err := parseAlacrittyColors(out)
+			if err != nil {
+				t.Fatal(err)
+			}
+			samePalette(t, got, p)
+		},
+		"windows-terminal": func(t *testing.T, out string) {
+			got, err := parseWTScheme([]byte(out))
+			if err != nil {
+				t.Fatal(err)
+			}
+			samePalette(t, got, p)
+		},
+		"rio": func(t *testing.T, out string) {
+			tree, err := ParseTOML(out)
+			if err != nil {
+				t.Fatal(err)
+			}
+			values := map[string]string{}
+			flattenTOMLStrings("", tree, values)
+			expectValue(t, values, "colors.background", p.Background)
+			expectValue(t, values, "colors.red", p.Normal.Red)
+			expectValue(t, values, "colors.light-white", p.Bright.White)
+		},
+		"tilix": func(t *testing.T, out string) {
+			var scheme struct {
+				Name       string   `json:"name"`
+				Background string   `json:"background-color"`
+				Palette    []string `json:"palette"`
+			}
+			if err := json.Unmarshal([]byte(out), &scheme); err != nil {
+				t.Fatal(err)
+			}
+			if scheme.Name != "Prueba" || scheme.Background != p.Background || strings.Join(scheme.Palette, ",") != strings.Join(colors, ",") {
+				t.Errorf("esquema Tilix = %+v", scheme)
+			}
+		},
+		"kitty": func(t *testing.T, out string) {
+			values := lineValues(out, " ")
+			if got := values["background"]; len(got) != 1 || got[0] != p.Background {
+				t.Errorf("background = %v, want %s", got, p.Background)
+			}
+			for i, c := range colors {
+				if got := values[fmt.Sprintf("color%d", i)]; len(got) != 1 || got[0] != c {
+					t.Errorf("color%d = %v, want %s", i, got, c)
+				}
+			}
+			if _, ok := values["shell"]; ok {
+				t.Error("la exportación no debe fijar el shell")
+			}
+		},
+		"ghostty": func(t *testing.T, out string) {
+			values := lineValues(out, "=")
+			if got := values["background"]; len(got) != 1 || got[0] != p.Background {
+				t.Errorf("background = %v, want %s", got, p.Background)
+			}
+			var want []string
+			for i, c := range colors {
+				want = append(want, fmt.Sprintf("%d=%s", i, c))
+			}
+			if got := strings.Join(values["palette"], " "); got != strings.Join(want, " ") {
+				t.Errorf("palette = %s", got)
+			}
+		},
+		"wezterm": func(t *testing.T, out string) {
+			values := lineValues(out, "=")
+			if got := values["config.colors.background"]; len(got) != 1 || got[0] != "'"+p.Background+"'" {
+				t.Errorf("config.colors.background = %v, want '%s'", got, p.Background)
+			}
+			ansi := strings.Join(values["config.colors.ansi"], "")
+			for _, c := range p.Normal.List() {
+				if !strings.Contains(ansi, "'"+c+"'") {
+					t.Errorf("config.colors.ansi = %s, falta %s", ansi, c)
+				}
+			}
+			if strings.Contains(out, "default_prog") {
+				t.Error("la exportación no debe fijar default_prog")
+			}
+		},
+		"gnome": func(t *testing.T, out string) {
+			values := iniValues(out)
+			expectValue(t, values, ":"+XebecGnomeProfileUUID+".background-color", "'"+p.Background+"'")
+			expectValue(t, values, ":"+XebecGnomeProfileUUID+".palette", "['"+strings.Join(colors, "', '")+"']")
+		},
+		"konsole": func(t *testing.T, out string) {
+			values := iniValues(out)
+			expectValue(t, values, "Background.Color", "16,24,32")
+			expectValue(t, values, "Color1.Color", "192,48,48")
+			expectValue(t, values, "General.Description", "Prueba")
+		},
+		"xfce": func(t *testing.T, out string) {
+			values := iniValues(out)
+			expectValue(t, values, "Scheme.ColorBackground", p.Background)
+			expectValue(t, values, "Scheme.ColorPalette", strings.Join(colors, ";"))
+		},
+		"foot": func(t *testing.T, out string) {
+			values := iniValues(out)
+			expectValue(t, values, "colors.background", strings.TrimPrefix(p.Background, "#"))
+			expectValue(t, values, "colors.regular1", strings.TrimPrefix(p.Normal.Red, "#"))
+			expectValue(t, values, "colors.bright7", strings.TrimPrefix(p.Bright.White, "#"))
+		},
+		"xresources": func(t *testing.T, out string) {
+			values := lineValues(out, ":")
+			if got := values["*.background"]; len(got) != 1 || got[0] != p.Background {
+				t.Errorf("*.background = %v, want %s", got, p.Background)
+			}
+			if got := values["*.color15"]; len(got) != 1 || got[0] != p.Bright.White {
+				t.Errorf("*.color15 = %v, want %s", got, p.Bright.White)
+			}
+		},
+		"delta": func(t *testing.T, out string) {
+			values := iniValues(out)
+			expectValue(t, values, "delta.syntax-theme", "Prueba")
+			expectValue(t, values, "delta.dark", "true")
+		},
+		"bat": func(t *testing.T, out string) {
+			dec := xml.NewDecoder(strings.NewReader(out))
+			for {
+				if _, err := dec.Token(); err == io.EOF {
+					break
+				} else if err != nil {
+					t.Fatalf("tmTheme no es XML válido: %v", err)
+				}
+			}
+			if !strings.Contains(out, "<string>"+p.Background+"</string>") {
+				t.Errorf("tmTheme sin el fondo %s", p.Background)
+			}
+		},
+		"ls_colors": func(t *testing.T, out string) {
+			values := map[string]string{}
+			for _, entry := range strings.Split(strings.TrimSpace(out), ":") {
+				k, v, ok := strings.Cut(entry, "=")
+				if !ok {
+					t.Fatalf("entrada sin '=': %q", entry)
+				}
+				values[k] = v
+			}
+			expectValue(t, values, "di", "1;38;2;48;96;192")
+		},
+		"fzf": func(t *testing.T, out string) {
+			value, ok := strings.CutPrefix(strings.TrimSpace(out), "--color=")
+			if !ok {
+				t.Fatalf("sin --color=: %q", out)
+			}
+			values := map[string]string{}
+			for _, entry := range strings.Split(value, ",") {
+				k, v, _ := strings.Cut(entry, ":")
+				values[k] = v
+			}
+			expectValue(t, values, "fg", p.Foreground)
+			expectValue(t, values, "hl", p.Normal.Blue)
+		},
+	}
+
+	emptyHeader := regexp.MustCompile(`(?m)Generado por xebec:\s*$`)
+	for _, format := range GetThemeExportFormats() {
+		t.Run(format, func(t *testing.T) {
+			check, ok := checks[format]
+			if !ok {
+				t.Fatalf("sin comprobación para el formato %s", format)
+			}
+			out, err := ExportTheme(theme, format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if emptyHeader.MatchString(out) {
+				t.Errorf("cabecera sin ruta:\n%s", out)
+			}
+			check(t, out)
+		})
+	}
+
+	if _, err := ExportTheme(theme, "nope"); err == nil {
+		t.Error("ExportTheme con un formato desconocido debería fallar")
+	}
+}
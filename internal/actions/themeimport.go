@@ -0,0 +1,470 @@
+// Package: actions
+// Importación de esquemas de colores externos al modelo de tema XEBEC
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Formatos de importación soportados
+const (
+	ThemeFormatBase16    = "base16"
+	ThemeFormatITerm     = "iterm"
+	ThemeFormatGogh      = "gogh"
+	ThemeFormatWindows   = "windows-terminal"
+	ThemeFormatAlacritty = "alacritty"
+)
+
+// ThemeImportFormats formatos aceptados por ImportTheme
+var ThemeImportFormats = []string{ThemeFormatBase16, ThemeFormatITerm, ThemeFormatGogh, ThemeFormatWindows, ThemeFormatAlacritty}
+
+// DetectThemeFormat deduce el formato por la extensión y, en JSON, por las claves
+func DetectThemeFormat(path string, data []byte) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return ThemeFormatBase16, nil
+	case ".itermcolors", ".plist":
+		return ThemeFormatITerm, nil
+	case ".toml":
+		return ThemeFormatAlacritty, nil
+	case ".json":
+		switch {
+		case bytes.Contains(data, []byte(`"color_01"`)):
+			return ThemeFormatGogh, nil
+		case bytes.Contains(data, []byte(`"brightBlack"`)):
+			return ThemeFormatWindows, nil
+		}
+	}
+	return "", fmt.Errorf("no se reconoce el formato de %s; indícalo con --format (%s)", filepath.Base(path), strings.Join(ThemeImportFormats, ", "))
+}
+
+// ImportTheme convierte un esquema externo al modelo de tema XEBEC
+// name reemplaza el nombre del esquema; si ambos están vacíos se usa el nombre del archivo
+func ImportTheme(path, format, name string) (Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Theme{}, fmt.Errorf("error leyendo %s: %w", path, err)
+	}
+	if format == "" {
+		if format, err = DetectThemeFormat(path, data); err != nil {
+			return Theme{}, err
+		}
+	}
+
+	var palette TerminalPalette
+	switch format {
+	case ThemeFormatBase16:
+		palette, err = parseBase16(data)
+	case ThemeFormatITerm:
+		palette, err = parseITermColors(data)
+	case ThemeFormatGogh:
+		palette, err = parseGogh(data)
+	case ThemeFormatWindows:
+		palette, err = parseWTScheme(data)
+	case ThemeFormatAlacritty:
+		palette, err = parseAlacrittyColors(string(data))
+	default:
+		return Theme{}, fmt.Errorf("formato desconocido: %s (disponibles: %s)", format, strings.Join(ThemeImportFormats, ", "))
+	}
+	if err != nil {
+		return Theme{}, fmt.Errorf("error importando %s: %w", filepath.Base(path), err)
+	}
+
+	if name != "" {
+		palette.Name = name
+	}
+	if palette.Name == "" {
+		palette.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	return NewTheme(palette)
+}
+
+// NewTheme completa una paleta (cursor y selección por defecto), deduce la variante
+// y los acentos de UI, y valida el resultado
+func NewTheme(p TerminalPalette) (Theme, error) {
+	if p.Cursor == "" {
+		p.Cursor = p.Foreground
+	}
+	if p.Selection == "" {
+		p.Selection = mixHex(p.Foreground, p.Background, 0.25)
+	}
+
+	theme := Theme{TerminalPalette: p, Variant: "dark"}
+	if relativeLuminance(p.Background) > 0.5 {
+		theme.Variant = "light"
+	}
+	theme.UI = deriveUIColors(p)
+
+	data, err := json.Marshal(theme)
+	if err != nil {
+		return Theme{}, fmt.Errorf("error generando tema: %w", err)
+	}
+	return ParseTheme(data)
+}
+
+// deriveUIColors construye los acentos del TUI a partir de la paleta de terminal
+// En temas claros el "blanco" de la UI (texto) es el color de texto del tema
+func deriveUIColors(p TerminalPalette) UIColors {
+	white, secondary := p.Bright.White, p.Normal.Black
+	if relativeLuminance(p.Background) > 0.5 {
+		white, secondary = p.Foreground, p.Background
+	}
+	return UIColors{
+		Primary:       p.Normal.Blue,
+		Secondary:     secondary,
+		White:         white,
+		AccentCyan:    p.Normal.Cyan,
+		AccentGreen:   p.Normal.Green,
+		AccentOrange:  mixHex(p.Normal.Red, p.Normal.Yellow, 0.5),
+		AccentRed:     p.Normal.Red,
+		AccentYellow:  p.Normal.Yellow,
+		AccentPurple:  p.Bright.Magenta,
+		GradientStart: p.Normal.Blue,
+		GradientEnd:   p.Bright.Magenta,
+		GrayDark:      p.Selection,
+		Gray:          mixHex(p.Foreground, p.Background, 0.2),
+		GrayLight:     p.Bright.Black,
+		GrayLighter:   mixHex(p.Foreground, p.Background, 0.6),
+	}
+}
+
+// relativeLuminance luminancia relativa WCAG de un color #RRGGBB (0-1)
+func relativeLuminance(hex string) float64 {
+	rgb := hexComponents(hex)
+	return 0.2126*srgbToLinear(rgb[0]) + 0.7152*srgbToLinear(rgb[1]) + 0.0722*srgbToLinear(rgb[2])
+}
+
+// normalizeHex convierte "#rgb", "rrggbb", "0xRRGGBB" o "#RRGGBB" a "#RRGGBB"
+func normalizeHex(value string) (string, error) {
+	v := strings.Trim(strings.TrimSpace(value), `"'`)
+	v = strings.TrimPrefix(strings.TrimPrefix(v, "0x"), "#")
+	if len(v) == 3 {
+		v = string([]byte{v[0], v[0], v[1], v[1], v[2], v[2]})
+	}
+	if len(v) != 6 {
+		return "", fmt.Errorf("color inválido: %q", value)
+	}
+	if _, err := strconv.ParseUint(v, 16, 32); err != nil {
+		return "", fmt.Errorf("color inválido: %q", value)
+	}
+	return "#" + strings.ToUpper(v), nil
+}
+
+// paletteFromList arma una paleta desde 16 colores ANSI ya normalizados
+func paletteFromList(colors []string) (ANSIColors, ANSIColors) {
+	row := func(c []string) ANSIColors {
+		return ANSIColors{Black: c[0], Red: c[1], Green: c[2], Yellow: c[3], Blue: c[4], Magenta: c[5], Cyan: c[6], White: c[7]}
+	}
+	return row(colors[0:8]), row(colors[8:16])
+}
+
+// parseBase16 lee un esquema base16/base24 en YAML (formato clásico o con "palette:")
+// Usa el mapeo de base16-shell: base08-0E son los colores y base00-07 los grises
+func parseBase16(data []byte) (TerminalPalette, error) {
+	values := map[string]string{}
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if i := strings.Index(value, " #"); i >= 0 {
+			value = strings.TrimSpace(value[:i])
+		}
+		values[strings.ToLower(strings.TrimSpace(key))] = strings.Trim(value, `"'`)
+	}
+
+	base := make(map[string]string, 16)
+	for i := 0; i < 16; i++ {
+		key := fmt.Sprintf("base%02X", i)
+		hex, err := normalizeHex(values[strings.ToLower(key)])
+		if err != nil {
+			return TerminalPalette{}, fmt.Errorf("%s: %w", key, err)
+		}
+		base[key] = hex
+	}
+
+	name := values["scheme"]
+	if name == "" {
+		name = values["name"]
+	}
+	colors := []string{
+		base["base00"], base["base08"], base["base0B"], base["base0A"], base["base0D"], base["base0E"], base["base0C"], base["base05"],
+		base["base03"], base["base08"], base["base0B"], base["base0A"], base["base0D"], base["base0E"], base["base0C"], base["base07"],
+	}
+	normal, bright := paletteFromList(colors)
+	return TerminalPalette{
+		Name:       name,
+		Background: base["base00"],
+		Foreground: base["base05"],
+		Cursor:     base["base05"],
+		Selection:  base["base02"],
+		Normal:     normal,
+		Bright:     bright,
+	}, nil
+}
+
+// parseITermColors lee un .itermcolors (plist XML con componentes RGB entre 0 y 1)
+func parseITermColors(data []byte) (TerminalPalette, error) {
+	colors := map[string]string{}
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	var key, component string
+	var rgb [3]float64
+	depth := 0
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			break
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "dict":
+				depth++
+				rgb = [3]float64{}
+			case "key":
+				var text string
+				if err := dec.DecodeElement(&text, &t); err != nil {
+					return TerminalPalette{}, fmt.Errorf("plist inválido: %w", err)
+				}
+				if depth == 1 {
+					key = text
+				} else {
+					component = text
+				}
+			case "real", "integer":
+				var text string
+				if err := dec.DecodeElement(&text, &t); err != nil {
+					return TerminalPalette{}, fmt.Errorf("plist inválido: %w", err)
+				}
+				v, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+				if err != nil {
+					return TerminalPalette{}, fmt.Errorf("componente inválido en %s: %q", key, text)
+				}
+				switch component {
+				case "Red Component":
+					rgb[0] = v
+				case "Green Component":
+					rgb[1] = v
+				case "Blue Component":
+					rgb[2] = v
+				}
+			}
+		case xml.EndElement:
+			if t.Name.Local == "dict" {
+				if depth == 2 && key != "" {
+					colors[key] = fmt.Sprintf("#%02X%02X%02X", unitToByte(rgb[0]), unitToByte(rgb[1]), unitToByte(rgb[2]))
+				}
+				depth--
+			}
+		}
+	}
+
+	list := make([]string, 16)
+	for i := range list {
+		c, ok := colors[fmt.Sprintf("Ansi %d Color", i)]
+		if !ok {
+			return TerminalPalette{}, fmt.Errorf("falta Ansi %d Color", i)
+		}
+		list[i] = c
+	}
+	if colors["Background Color"] == "" || colors["Foreground Color"] == "" {
+		return TerminalPalette{}, fmt.Errorf("faltan Background Color o Foreground Color")
+	}
+	normal, bright := paletteFromList(list)
+	return TerminalPalette{
+		Background: colors["Background Color"],
+		Foreground: colors["Foreground Color"],
+		Cursor:     colors["Cursor Color"],
+		Selection:  colors["Selection Color"],
+		Normal:     normal,
+		Bright:     bright,
+	}, nil
+}
+
+// unitToByte convierte un componente 0-1 a 0-255
+func unitToByte(v float64) uint8 {
+	return uint8(math.Round(math.Max(0, math.Min(1, v)) * 255))
+}
+
+// parseGogh lee un tema de Gogh en JSON (color_01 a color_16)
+func parseGogh(data []byte) (TerminalPalette, error) {
+	raw, err := jsonStrings(data)
+	if err != nil {
+		return TerminalPalette{}, fmt.Errorf("JSON inválido: %w", err)
+	}
+
+	list := make([]string, 16)
+	for i := range list {
+		key := fmt.Sprintf("color_%02d", i+1)
+		hex, err := normalizeHex(raw[key])
+		if err != nil {
+			return TerminalPalette{}, fmt.Errorf("%s: %w", key, err)
+		}
+		list[i] = hex
+	}
+	palette := TerminalPalette{Name: raw["name"]}
+	if err := normalizeFields(raw, map[string]*string{
+		"background": &palette.Background,
+		"foreground": &palette.Foreground,
+		"cursor":     &palette.Cursor,
+	}, "background", "foreground"); err != nil {
+		return TerminalPalette{}, err
+	}
+	palette.Normal, palette.Bright = paletteFromList(list)
+	return palette, nil
+}
+
+// parseWTScheme lee un esquema de Windows Terminal (objeto o array de "schemes"; se usa el primero)
+func parseWTScheme(data []byte) (TerminalPalette, error) {
+	data = StripJSONC(data)
+	raw, err := jsonStrings(data)
+	if err != nil {
+		var list []json.RawMessage
+		if errList := json.Unmarshal(data, &list); errList != nil || len(list) == 0 {
+			return TerminalPalette{}, fmt.Errorf("JSON inválido: %w", err)
+		}
+		if raw, err = jsonStrings(list[0]); err != nil {
+			return TerminalPalette{}, fmt.Errorf("JSON inválido: %w", err)
+		}
+	}
+
+	palette := TerminalPalette{Name: raw["name"]}
+	fields := map[string]*string{
+		"background":          &palette.Background,
+		"foreground":          &palette.Foreground,
+		"cursorColor":         &palette.Cursor,
+		"selectionBackground": &palette.Selection,
+	}
+	required := []string{"background", "foreground"}
+	wtKey := func(name string) string {
+		if name == "magenta" {
+			return "purple"
+		}
+		return name
+	}
+	required = ansiFields(fields, required, &palette.Normal, wtKey)
+	required = ansiFields(fields, required, &palette.Bright, func(name string) string {
+		name = wtKey(name)
+		return "bright" + strings.ToUpper(name[:1]) + name[1:]
+	})
+	if err := normalizeFields(raw, fields, required...); err != nil {
+		return TerminalPalette{}, err
+	}
+	return palette, nil
+}
+
+// normalizeFields normaliza los colores de raw en sus campos; los de required son obligatorios
+func normalizeFields(raw map[string]string, fields map[string]*string, required ...string) error {
+	for _, key := range required {
+		if raw[key] == "" {
+			return fmt.Errorf("falta %s", key)
+		}
+	}
+	for key, field := range fields {
+		if raw[key] == "" {
+			continue
+		}
+		hex, err := normalizeHex(raw[key])
+		if err != nil {
+			if isRequired(key, required) {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+			continue // p. ej. cursor = "CellForeground" en Alacritty
+		}
+		*field = hex
+	}
+	return nil
+}
+
+// isRequired indica si key está en required
+func isRequired(key string, required []string) bool {
+	for _, r := range required {
+		if r == key {
+			return true
+		}
+	}
+	return false
+}
+
+// ansiKeys nombres de los 8 colores ANSI en orden; Windows Terminal usa purple en vez de magenta
+var ansiKeys = []string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+
+// ansiFields asocia cada color de row con su clave (prefijo + nombre) y la añade a required
+func ansiFields(fields map[string]*string, required []string, row *ANSIColors, key func(name string) string) []string {
+	targets := []*string{&row.Black, &row.Red, &row.Green, &row.Yellow, &row.Blue, &row.Magenta, &row.Cyan, &row.White}
+	for i, name := range ansiKeys {
+		k := key(name)
+		fields[k] = targets[i]
+		required = append(required, k)
+	}
+	return required
+}
+
+// jsonStrings decodifica un objeto JSON quedándose con los valores string
+func jsonStrings(data []byte) (map[string]string, error) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	out := make(map[string]string, len(raw))
+	for k, v := range raw {
+		if s, ok := v.(string); ok {
+			out[k] = s
+		}
+	}
+	return out, nil
+}
+
+// parseAlacrittyColors lee las tablas [colors.*] de un alacritty.toml
+// Con ParseTOML valen tanto las tablas como las claves con puntos y las tablas en línea
+func parseAlacrittyColors(content string) (TerminalPalette, error) {
+	tree, err := ParseTOML(content)
+	if err != nil {
+		return TerminalPalette{}, fmt.Errorf("TOML inválido: %w", err)
+	}
+	values := map[string]string{}
+	flattenTOMLStrings("", tree, values)
+
+	palette := TerminalPalette{}
+	fields := map[string]*string{
+		"colors.primary.background":   &palette.Background,
+		"colors.primary.foreground":   &palette.Foreground,
+		"colors.cursor.cursor":        &palette.Cursor,
+		"colors.selection.background": &palette.Selection,
+	}
+	required := []string{"colors.primary.background", "colors.primary.foreground"}
+	required = ansiFields(fields, required, &palette.Normal, func(name string) string { return "colors.normal." + name })
+	required = ansiFields(fields, required, &palette.Bright, func(name string) string { return "colors.bright." + name })
+	if err := normalizeFields(values, fields, required...); err != nil {
+		return TerminalPalette{}, err
+	}
+	return palette, nil
+}
+
+// flattenTOMLStrings copia los valores string de un árbol TOML con sus claves completas (a.b.c)
+func flattenTOMLStrings(prefix string, table map[string]interface{}, out map[string]string) {
+	for key, value := range table {
+		switch v := value.(type) {
+		case string:
+			out[prefix+key] = v
+		case map[string]interface{}:
+			flattenTOMLStrings(prefix+key+".", v, out)
+		}
+	}
+}
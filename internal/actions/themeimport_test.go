@@ -0,0 +1,99 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSaveThemeRefusesExisting(t *testing.T) {
+	setupPlanHome(t)
+
+	// Un tema integrado no se oculta sin overwrite
+	builtin := DefaultTheme()
+	if _, err := SaveTheme(builtin, false); err == nil {
+		t.Error("se guardó un tema que oculta al integrado xebec")
+	}
+
+	theme := DefaultTheme()
+	theme.Name = "Gruvbox"
+	if _, err := SaveTheme(theme, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := SaveTheme(theme, false); err == nil {
+		t.Error("se sobrescribió un tema importado sin overwrite")
+	}
+	if _, err := SaveTheme(theme, true); err != nil {
+		t.Errorf("overwrite: %v", err)
+	}
+	if _, err := SaveTheme(builtin, true); err != nil {
+		t.Errorf("overwrite de un integrado: %v", err)
+	}
+}
+
+func TestImportTheme(t *testing.T) {
+	// Los fixtures (salvo base16) describen la misma paleta en cada formato
+	gruvbox := TerminalPalette{
+		Background: "#1D2021",
+		Foreground: "#EBDBB2",
+		Cursor:     "#FE8019",
+		Selection:  "#504945",
+		Normal:     ANSIColors{Black: "#282828", Red: "#CC241D", Green: "#98971A", Yellow: "#D79921", Blue: "#458588", Magenta: "#B16286", Cyan: "#689D6A", White: "#A89984"},
+		Bright:     ANSIColors{Black: "#928374", Red: "#FB4934", Green: "#B8BB26", Yellow: "#FABD2F", Blue: "#83A598", Magenta: "#D3869B", Cyan: "#8EC07C", White: "#F9F5D7"},
+	}
+	named := func(p TerminalPalette, name string) TerminalPalette {
+		p.Name = name
+		return p
+	}
+	// Gogh no trae selección: se deriva del texto y el fondo
+	gogh := named(gruvbox, "Gruvbox Gogh")
+	gogh.Selection = mixHex(gogh.Foreground, gogh.Background, 0.25)
+	// base16-shell: base08-0E para los colores, base03 y base07 para negro y blanco brillantes
+	base16Colors := ANSIColors{Red: "#FB4934", Green: "#B8BB26", Yellow: "#FABD2F", Blue: "#83A598", Magenta: "#D3869B", Cyan: "#8EC07C"}
+	base16 := TerminalPalette{
+		Name:       "Gruvbox base16",
+		Background: "#1D2021",
+		Foreground: "#D5C4A1",
+		Cursor:     "#D5C4A1",
+		Selection:  "#504945",
+		Normal:     base16Colors,
+		Bright:     base16Colors,
+	}
+	base16.Normal.Black, base16.Normal.White = "#1D2021", "#D5C4A1"
+	base16.Bright.Black, base16.Bright.White = "#665C54", "#FBF1C7"
+
+	tests := []struct {
+		fixture string
+		format  string
+		want    TerminalPalette
+	}{
+		{fixture: "gruvbox-base16.yaml", format: ThemeFormatBase16, want: base16},
+		{fixture: "gruvbox.itermcolors", format: ThemeFormatITerm, want: named(gruvbox, "gruvbox")},
+		{fixture: "gruvbox-gogh.json", format: ThemeFormatGogh, want: gogh},
+		{fixture: "gruvbox-wt.json", format: ThemeFormatWindows, want: named(gruvbox, "Gruvbox WT")},
+		{fixture: "gruvbox.toml", format: ThemeFormatAlacritty, want: named(gruvbox, "gruvbox")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			path := filepath.Join("testdata", "themeimport", tt.fixture)
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if format, err := DetectThemeFormat(path, data); err != nil || format != tt.format {
+				t.Errorf("DetectThemeFormat() = %q, %v; want %q", format, err, tt.format)
+			}
+
+			theme, err := ImportTheme(path, "", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if theme.TerminalPalette != tt.want {
+				t.Errorf("paleta importada:\n got %+v\nwant %+v", theme.TerminalPalette, tt.want)
+			}
+			if theme.Variant != "dark" || theme.UI.Primary != tt.want.Normal.Blue {
+				t.Errorf("variante %q y primario %s, want dark y %s", theme.Variant, theme.UI.Primary, tt.want.Normal.Blue)
+			}
+		})
+	}
+}
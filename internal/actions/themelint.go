@@ -0,0 +1,221 @@
+// Package: actions
+// Validación de temas: contraste WCAG y legibilidad con daltonismo
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Umbrales de contraste (WCAG 2.x)
+const (
+	ContrastText = 4.5 // AA para texto normal
+	ContrastUI   = 3.0 // AA para texto grande y componentes
+	cvdMinDeltaE = 20  // Diferencia mínima (CIE76) entre colores que deben distinguirse
+)
+
+// Gravedad de un problema del tema
+const (
+	LintError   = "error"
+	LintWarning = "warning"
+)
+
+// ThemeIssue problema de legibilidad de un tema
+type ThemeIssue struct {
+	Severity string  `json:"severity"`
+	Key      string  `json:"key"`     // Color afectado (p. ej. bright.black)
+	Color    string  `json:"color"`   // Valor del color
+	Against  string  `json:"against"` // Color con el que se compara
+	Ratio    float64 `json:"ratio,omitempty"`
+	Message  string  `json:"message"`
+}
+
+// String formatea el problema en una línea
+func (i ThemeIssue) String() string {
+	return fmt.Sprintf("%s %s: %s", i.Key, i.Color, i.Message)
+}
+
+// cvdSimulation simulación de un tipo de daltonismo (Machado et al. 2009, severidad 1.0)
+type cvdSimulation struct {
+	Name   string
+	Matrix [3][3]float64
+}
+
+// cvdSimulations tipos de daltonismo que se comprueban
+var cvdSimulations = []cvdSimulation{
+	{Name: "deuteranopia", Matrix: [3][3]float64{
+		{0.367322, 0.860646, -0.227968},
+		{0.280085, 0.672501, 0.047413},
+		{-0.011820, 0.042940, 0.968881},
+	}},
+	{Name: "protanopia", Matrix: [3][3]float64{
+		{0.152286, 1.052583, -0.204868},
+		{0.114503, 0.786281, 0.099216},
+		{-0.003882, -0.048116, 1.051998},
+	}},
+}
+
+// LintTheme comprueba el contraste del tema y su legibilidad con deuteranopia y protanopia
+func LintTheme(theme Theme) []ThemeIssue {
+	p := theme.TerminalPalette
+	bg := p.Background
+	var issues []ThemeIssue
+
+	check := func(key, color, against string, min float64, severity, what string) bool {
+		ratio := ContrastRatio(color, against)
+		if ratio >= min {
+			return true
+		}
+		issues = append(issues, ThemeIssue{
+			Severity: severity, Key: key, Color: color, Against: against, Ratio: ratio,
+			Message: fmt.Sprintf("contraste %.2f:1 sobre %s, mínimo %.1f:1 para %s", ratio, against, min, what),
+		})
+		return false
+	}
+
+	check("foreground", p.Foreground, bg, ContrastText, LintError, "texto")
+	check("foreground", p.Foreground, p.Selection, ContrastText, LintWarning, "texto seleccionado")
+	check("cursor", p.Cursor, bg, ContrastUI, LintWarning, "el cursor")
+
+	for _, c := range themeTextColors(theme) {
+		if !check(c.key, c.color, bg, ContrastUI, LintWarning, "texto de color") {
+			continue
+		}
+		for _, sim := range cvdSimulations {
+			simColor, simBg := sim.apply(c.color), sim.apply(bg)
+			if ratio := ContrastRatio(simColor, simBg); ratio < ContrastUI {
+				issues = append(issues, ThemeIssue{
+					Severity: LintWarning, Key: c.key, Color: c.color, Against: bg, Ratio: ratio,
+					Message: fmt.Sprintf("con %s se ve %s, contraste %.2f:1 sobre el fondo", sim.Name, simColor, ratio),
+				})
+			}
+		}
+	}
+
+	// Rojo y verde (errores/éxito, diffs) deben distinguirse entre sí
+	for _, row := range []struct {
+		name string
+		c    ANSIColors
+	}{{"normal", p.Normal}, {"bright", p.Bright}} {
+		for _, sim := range cvdSimulations {
+			red, green := sim.apply(row.c.Red), sim.apply(row.c.Green)
+			if d := deltaE(red, green); d < cvdMinDeltaE {
+				issues = append(issues, ThemeIssue{
+					Severity: LintWarning, Key: row.name + ".red", Color: row.c.Red, Against: row.c.Green,
+					Message: fmt.Sprintf("con %s casi no se distingue de %s.green %s (ΔE %.0f)", sim.Name, row.name, row.c.Green, d),
+				})
+			}
+		}
+	}
+	return issues
+}
+
+// LintErrors cuenta los problemas de gravedad error
+func LintErrors(issues []ThemeIssue) int {
+	n := 0
+	for _, i := range issues {
+		if i.Severity == LintError {
+			n++
+		}
+	}
+	return n
+}
+
+// themeColor color con nombre de un tema
+type themeColor struct {
+	key   string
+	color string
+}
+
+// themeTextColors retorna los colores ANSI que se usan como texto sobre el fondo
+// Se excluye el color que hace de fondo (black en oscuros, white en claros)
+func themeTextColors(theme Theme) []themeColor {
+	var out []themeColor
+	for _, row := range []struct {
+		name string
+		c    ANSIColors
+	}{{"normal", theme.Normal}, {"bright", theme.Bright}} {
+		for i, color := range row.c.List() {
+			key := ansiKeys[i]
+			if theme.Variant == AppearanceLight && key == "white" {
+				continue
+			}
+			if theme.Variant != AppearanceLight && row.name == "normal" && key == "black" {
+				continue
+			}
+			out = append(out, themeColor{key: row.name + "." + key, color: color})
+		}
+	}
+	return out
+}
+
+// ContrastRatio retorna el contraste WCAG entre dos colores (1 a 21)
+func ContrastRatio(a, b string) float64 {
+	la, lb := relativeLuminance(a), relativeLuminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// apply simula cómo ve el color una persona con este tipo de daltonismo
+func (s cvdSimulation) apply(hex string) string {
+	rgb := hexComponents(hex)
+	lin := [3]float64{srgbToLinear(rgb[0]), srgbToLinear(rgb[1]), srgbToLinear(rgb[2])}
+	var out strings.Builder
+	out.WriteByte('#')
+	for _, row := range s.Matrix {
+		v := row[0]*lin[0] + row[1]*lin[1] + row[2]*lin[2]
+		fmt.Fprintf(&out, "%02X", linearToSRGB(v))
+	}
+	return out.String()
+}
+
+// deltaE diferencia perceptual CIE76 entre dos colores
+func deltaE(a, b string) float64 {
+	la, lb := hexToLab(a), hexToLab(b)
+	return math.Sqrt(math.Pow(la[0]-lb[0], 2) + math.Pow(la[1]-lb[1], 2) + math.Pow(la[2]-lb[2], 2))
+}
+
+// hexToLab convierte un color sRGB a CIELAB (D65)
+func hexToLab(hex string) [3]float64 {
+	rgb := hexComponents(hex)
+	r, g, b := srgbToLinear(rgb[0]), srgbToLinear(rgb[1]), srgbToLinear(rgb[2])
+	xyz := [3]float64{
+		(0.4124*r + 0.3576*g + 0.1805*b) / 0.95047,
+		0.2126*r + 0.7152*g + 0.0722*b,
+		(0.0193*r + 0.1192*g + 0.9505*b) / 1.08883,
+	}
+	for i, v := range xyz {
+		if v > 0.008856 {
+			xyz[i] = math.Cbrt(v)
+		} else {
+			xyz[i] = 7.787*v + 16.0/116
+		}
+	}
+	return [3]float64{116*xyz[1] - 16, 500 * (xyz[0] - xyz[1]), 200 * (xyz[1] - xyz[2])}
+}
+
+// srgbToLinear convierte un canal sRGB (0-255) a luz lineal (0-1)
+func srgbToLinear(c uint8) float64 {
+	v := float64(c) / 255
+	if v <= 0.03928 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+// linearToSRGB convierte luz lineal (0-1) a un canal sRGB (0-255)
+func linearToSRGB(v float64) uint8 {
+	v = math.Max(0, math.Min(1, v))
+	if v <= 0.0031308 {
+		v *= 12.92
+	} else {
+		v = 1.055*math.Pow(v, 1/2.4) - 0.055
+	}
+	return uint8(v*255 + 0.5)
+}
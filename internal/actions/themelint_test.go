@@ -0,0 +1,129 @@
+package actions
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestContrastRatio(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"#FFFFFF", "#000000", 21},
+		{"#000000", "#FFFFFF", 21},
+		{"#777777", "#777777", 1},
+		{"#777777", "#FFFFFF", 4.48},
+		{"#4A4A4A", "#000000", 2.37},
+	}
+	for _, tt := range tests {
+		if got := ContrastRatio(tt.a, tt.b); math.Abs(got-tt.want) > 0.01 {
+			t.Errorf("ContrastRatio(%s, %s) = %.2f, want %.2f", tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	// El ejemplo de la petición: bright.black sobre negro no llega ni a texto normal
+	if ratio := ContrastRatio("#4A4A4A", "#000000"); ratio >= ContrastText {
+		t.Errorf("#4A4A4A sobre #000000 = %.2f:1, want < %.1f:1", ratio, ContrastText)
+	}
+}
+
+func TestCVDSimulation(t *testing.T) {
+	for _, sim := range cvdSimulations {
+		// Grises, blanco y negro no cambian
+		for _, gray := range []string{"#000000", "#FFFFFF", "#808080"} {
+			if got := sim.apply(gray); deltaE(got, gray) > 1 {
+				t.Errorf("%s(%s) = %s, want %s", sim.Name, gray, got, gray)
+			}
+		}
+		// Azul y amarillo siguen distinguiéndose
+		if d := deltaE(sim.apply("#0000FF"), sim.apply("#FFFF00")); d < cvdMinDeltaE {
+			t.Errorf("%s: azul y amarillo con ΔE %.0f, want >= %d", sim.Name, d, cvdMinDeltaE)
+		}
+	}
+
+	// Con deuteranopia el rojo y el verde de XEBEC se confunden
+	deuteranopia := cvdSimulations[0]
+	if d := deltaE(deuteranopia.apply("#FF4C4C"), deuteranopia.apply("#4CAF50")); d >= cvdMinDeltaE {
+		t.Errorf("deuteranopia: rojo y verde con ΔE %.0f, want < %d", d, cvdMinDeltaE)
+	}
+}
+
+func TestLintTheme(t *testing.T) {
+	base := DefaultTheme()
+	tests := []struct {
+		name   string
+		modify func(p *TerminalPalette)
+		want   []string // Prefijos "<gravedad> <clave>" que deben aparecer
+		absent []string // Prefijos que no deben aparecer
+	}{
+		{
+			name:   "texto ilegible",
+			modify: func(p *TerminalPalette) { p.Foreground = "#4A4A4A" },
+			want:   []string{"error foreground"},
+		},
+		{
+			name:   "bright.black sobre negro",
+			modify: func(p *TerminalPalette) {},
+			want:   []string{"warning bright.black"},
+			absent: []string{"warning normal.black", "error"},
+		},
+		{
+			name: "rojo y verde que colapsan con deuteranopia",
+			modify: func(p *TerminalPalette) {
+				p.Normal.Red, p.Normal.Green = "#E06C75", "#98C379"
+			},
+			want: []string{"warning normal.red"},
+		},
+		{
+			name: "rojo y verde distinguibles",
+			modify: func(p *TerminalPalette) {
+				p.Normal.Red, p.Normal.Green = "#D55E00", "#56B4E9"
+			},
+			absent: []string{"warning normal.red"},
+		},
+		{
+			name:   "cursor invisible",
+			modify: func(p *TerminalPalette) { p.Cursor = "#0A0A0A" },
+			want:   []string{"warning cursor"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			theme := base
+			tt.modify(&theme.TerminalPalette)
+			var got []string
+			for _, issue := range LintTheme(theme) {
+				got = append(got, issue.Severity+" "+issue.Key)
+			}
+			joined := strings.Join(got, "\n")
+			for _, w := range tt.want {
+				if !strings.Contains(joined, w) {
+					t.Errorf("falta %q en:\n%s", w, joined)
+				}
+			}
+			for _, a := range tt.absent {
+				if strings.Contains(joined, a) {
+					t.Errorf("sobra %q en:\n%s", a, joined)
+				}
+			}
+		})
+	}
+}
+
+// Los temas incluidos no pueden tener errores: xebec theme lint falla en CI con ellos
+// (los avisos, como bright.black, son conocidos y solo fallan con --strict)
+func TestLintBuiltinThemes(t *testing.T) {
+	for _, name := range []string{"xebec", "xebec-light"} {
+		theme, err := LoadTheme(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, issue := range LintTheme(theme) {
+			if issue.Severity == LintError {
+				t.Errorf("%s: %s", name, issue)
+			}
+		}
+	}
+}
@@ -0,0 +1,425 @@
+// Package: actions
+// Gestión de temas: tema activo, variantes clara/oscura y reaplicación en las herramientas
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/XebecCorporation/XebecCorporation.Dots/themes"
+)
+
+// Apariencias del tema activo
+const (
+	AppearanceDark  = "dark"
+	AppearanceLight = "light"
+	AppearanceAuto  = "auto" // Sigue la apariencia del sistema operativo
+)
+
+// Appearances apariencias aceptadas por SwitchTheme
+var Appearances = []string{AppearanceDark, AppearanceLight, AppearanceAuto}
+
+// XebecState estado persistente de xebec (tema activo y herramientas configuradas)
+type XebecState struct {
+	Theme      string              `json:"theme"`      // Tema activo (slug, sin sufijo de variante)
+	Appearance string              `json:"appearance"` // dark, light o auto
+	Configured map[string][]string `json:"configured"` // Herramienta -> opciones aplicadas por última vez
+}
+
+// GetStatePath retorna la ruta del archivo de estado
+func GetStatePath() string {
+	return filepath.Join(GetXebecDataDir(), "state.json")
+}
+
+// LoadState lee el estado; si no existe retorna los valores por defecto
+func LoadState() (XebecState, error) {
+	state := XebecState{Theme: "xebec", Appearance: AppearanceDark, Configured: map[string][]string{}}
+	data, err := os.ReadFile(GetStatePath())
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return state, fmt.Errorf("error leyendo estado: %w", err)
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return state, fmt.Errorf("error parseando %s: %w", GetStatePath(), err)
+	}
+	if state.Configured == nil {
+		state.Configured = map[string][]string{}
+	}
+	return state, nil
+}
+
+// SaveState guarda el estado
+func SaveState(state XebecState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error generando estado: %w", err)
+	}
+	path := GetStatePath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("error creando directorio %s: %w", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("error escribiendo %s: %w", path, err)
+	}
+	return nil
+}
+
+// recordConfigured guarda las opciones de una herramienta si la configuración terminó sin error
+// Las escrituras hechas hasta el fallo se registran igualmente en el historial
+// Se usa con defer: defer recordConfigured("kitty", opts.modules(), &err)
+func recordConfigured(tool string, modules map[string]bool, err *error) {
+	flushHistory(tool)
+	if *err != nil {
+		return
+	}
+	state, loadErr := LoadState()
+	if loadErr != nil {
+		fmt.Printf("⚠ %v\n", loadErr)
+		return
+	}
+	state.Configured[tool] = enabledIDs(modules)
+	if saveErr := SaveState(state); saveErr != nil {
+		fmt.Printf("⚠ %v\n", saveErr)
+	}
+}
+
+// enabledIDs retorna las claves marcadas de un mapa de módulos, ordenadas
+func enabledIDs(modules map[string]bool) []string {
+	ids := make([]string, 0, len(modules))
+	for id, on := range modules {
+		if on {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// ThemeInfo familia de temas disponible para el gestor de temas
+type ThemeInfo struct {
+	Slug     string   // Slug de la familia (sin sufijo de variante)
+	Name     string   // Nombre del tema base
+	Variants []string // Variantes con archivo propio (las demás se derivan)
+	Builtin  bool
+}
+
+// ListThemes retorna los temas integrados e importados agrupados por familia
+// Los importados reemplazan a los integrados con el mismo slug
+func ListThemes() ([]ThemeInfo, error) {
+	type themeFile struct {
+		theme   Theme
+		builtin bool
+	}
+	bySlug := map[string]themeFile{}
+
+	entries, err := themes.Builtin.ReadDir(".")
+	if err != nil {
+		return nil, fmt.Errorf("error leyendo temas integrados: %w", err)
+	}
+	for _, e := range entries {
+		if data, err := themes.Builtin.ReadFile(e.Name()); err == nil {
+			if theme, err := ParseTheme(data); err == nil {
+				bySlug[strings.TrimSuffix(e.Name(), ".json")] = themeFile{theme: theme, builtin: true}
+			}
+		}
+	}
+
+	files, _ := filepath.Glob(filepath.Join(GetUserThemesDir(), "*.json"))
+	for _, f := range files {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			continue
+		}
+		theme, err := ParseTheme(data)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "⚠ Tema ignorado %s: %v\n", filepath.Base(f), err)
+			continue
+		}
+		bySlug[strings.TrimSuffix(filepath.Base(f), ".json")] = themeFile{theme: theme}
+	}
+
+	slugs := make([]string, 0, len(bySlug))
+	for slug := range bySlug {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+
+	byFamily := map[string]*ThemeInfo{}
+	var list []*ThemeInfo
+	for _, slug := range slugs {
+		file := bySlug[slug]
+		family := themeFamily(slug)
+		info, ok := byFamily[family]
+		if !ok {
+			info = &ThemeInfo{Slug: family, Name: file.theme.Name}
+			byFamily[family] = info
+			list = append(list, info)
+		}
+		if slug == family {
+			info.Name = file.theme.Name
+		}
+		if !containsID(info.Variants, file.theme.Variant) {
+			info.Variants = append(info.Variants, file.theme.Variant)
+		}
+		info.Builtin = info.Builtin || file.builtin
+	}
+
+	out := make([]ThemeInfo, 0, len(list))
+	for _, info := range list {
+		sort.Strings(info.Variants)
+		out = append(out, *info)
+	}
+	return out, nil
+}
+
+// themeFamily quita el sufijo de variante de un slug ("xebec-light" -> "xebec")
+func themeFamily(slug string) string {
+	for _, suffix := range []string{"-" + AppearanceLight, "-" + AppearanceDark} {
+		if base := strings.TrimSuffix(slug, suffix); base != slug {
+			return base
+		}
+	}
+	return slug
+}
+
+// ThemeVariant retorna la variante clara u oscura de un tema
+// Busca <familia>-<variante> y <familia>; si no existe la deriva de la paleta
+func ThemeVariant(theme Theme, variant string) Theme {
+	if theme.Variant == variant {
+		return theme
+	}
+	family := themeFamily(ThemeSlug(theme.Name))
+	for _, candidate := range []string{family + "-" + variant, family} {
+		if t, err := LoadTheme(candidate); err == nil && t.Variant == variant {
+			return t
+		}
+	}
+	return deriveThemeVariant(theme, variant)
+}
+
+// deriveThemeVariant genera la variante opuesta de un tema: invierte fondo y texto
+// y oscurece (o aclara) los colores para mantener el contraste
+func deriveThemeVariant(theme Theme, variant string) Theme {
+	p := theme.TerminalPalette
+	target, amount := "#FFFFFF", 0.7
+	if variant == AppearanceLight {
+		target = "#000000"
+	}
+	shift := func(c string) string { return mixHex(c, target, amount) }
+	row := func(r ANSIColors) ANSIColors {
+		return ANSIColors{
+			Black: r.Black, White: r.White,
+			Red: shift(r.Red), Green: shift(r.Green), Yellow: shift(r.Yellow),
+			Blue: shift(r.Blue), Magenta: shift(r.Magenta), Cyan: shift(r.Cyan),
+		}
+	}
+
+	out := TerminalPalette{
+		Name:       strings.TrimSpace(strings.TrimSuffix(strings.TrimSuffix(p.Name, " Dark"), " Light")),
+		Background: mixHex(p.Foreground, invertTarget(target), 0.1),
+		Foreground: mixHex(p.Background, target, 0.85),
+		Normal:     row(p.Normal),
+		Bright:     row(p.Bright),
+	}
+	if variant == AppearanceLight {
+		out.Name += " Light"
+	} else {
+		out.Name += " Dark"
+	}
+	out.Cursor = out.Normal.Blue
+	out.Selection = mixHex(out.Normal.Blue, out.Background, 0.2)
+
+	derived, err := NewTheme(out)
+	if err != nil {
+		return theme
+	}
+	return derived
+}
+
+// invertTarget retorna el extremo opuesto (#000000 <-> #FFFFFF)
+func invertTarget(target string) string {
+	if target == "#000000" {
+		return "#FFFFFF"
+	}
+	return "#000000"
+}
+
+// DetectOSAppearance detecta si el sistema usa apariencia clara u oscura
+// Retorna false si no se puede detectar
+func DetectOSAppearance() (string, bool) {
+	switch runtime.GOOS {
+	case "darwin":
+		// AppleInterfaceStyle solo existe en modo oscuro
+		out, err := exec.Command("defaults", "read", "-g", "AppleInterfaceStyle").Output()
+		if err == nil && strings.Contains(string(out), "Dark") {
+			return AppearanceDark, true
+		}
+		if _, lookErr := exec.LookPath("defaults"); lookErr == nil {
+			return AppearanceLight, true
+		}
+	case "windows":
+		out, err := exec.Command("reg", "query", `HKCU\Software\Microsoft\Windows\CurrentVersion\Themes\Personalize`, "/v", "AppsUseLightTheme").Output()
+		if err == nil {
+			if strings.Contains(string(out), "0x0") {
+				return AppearanceDark, true
+			}
+			return AppearanceLight, true
+		}
+	default:
+		if out, err := exec.Command("gsettings", "get", "org.gnome.desktop.interface", "color-scheme").Output(); err == nil {
+			switch value := strings.Trim(strings.TrimSpace(string(out)), "'"); value {
+			case "prefer-dark":
+				return AppearanceDark, true
+			case "prefer-light", "default":
+				return AppearanceLight, true
+			}
+		}
+		if gtk := os.Getenv("GTK_THEME"); gtk != "" {
+			if strings.HasSuffix(strings.ToLower(gtk), ":dark") || strings.Contains(strings.ToLower(gtk), "-dark") {
+				return AppearanceDark, true
+			}
+			return AppearanceLight, true
+		}
+	}
+	return "", false
+}
+
+// resolveAppearance convierte auto en dark o light (oscuro si no se detecta el sistema)
+func resolveAppearance(appearance string) string {
+	if appearance != AppearanceAuto {
+		return appearance
+	}
+	if detected, ok := DetectOSAppearance(); ok {
+		return detected
+	}
+	return AppearanceDark
+}
+
+// ResolveTheme carga un tema por nombre en la variante de la apariencia indicada
+func ResolveTheme(name, appearance string) (Theme, error) {
+	variant := resolveAppearance(appearance)
+	theme, err := LoadTheme(name)
+	if err != nil {
+		// Familias sin archivo base: <nombre>-dark / <nombre>-light
+		for _, candidate := range []string{variant, invertVariant(variant)} {
+			if t, variantErr := LoadTheme(name + "-" + candidate); variantErr == nil {
+				theme, err = t, nil
+				break
+			}
+		}
+		if err != nil {
+			return Theme{}, err
+		}
+	}
+	return ThemeVariant(theme, variant), nil
+}
+
+// invertVariant retorna la variante opuesta
+func invertVariant(variant string) string {
+	if variant == AppearanceLight {
+		return AppearanceDark
+	}
+	return AppearanceLight
+}
+
+// ActiveTheme retorna el tema activo según el estado guardado
+func ActiveTheme() (Theme, error) {
+	state, err := LoadState()
+	if err != nil {
+		return Theme{}, err
+	}
+	return ResolveTheme(state.Theme, state.Appearance)
+}
+
+// loadActiveTheme carga el tema activo; ante cualquier error usa el tema XEBEC integrado
+func loadActiveTheme() Theme {
+	theme, err := ActiveTheme()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠ Tema activo no disponible (%v); se usa XEBEC\n", err)
+		return DefaultTheme()
+	}
+	return theme
+}
+
+// SetActiveTheme cambia el tema en memoria que usan los configuradores
+func SetActiveTheme(theme Theme) {
+	activeThemeOnce.Do(func() {})
+	activeTheme = theme
+}
+
+// SwitchTheme activa un tema (en la apariencia indicada) y lo reaplica en las herramientas configuradas
+// Retorna el tema aplicado; los errores de cada herramienta se acumulan sin detener el resto
+func SwitchTheme(name, appearance string) (Theme, error) {
+	return switchTheme(name, appearance, nil)
+}
+
+// switchTheme cambia el tema sin regenerar las herramientas de skip (las reconfigura otro paso)
+func switchTheme(name, appearance string, skip map[string]bool) (Theme, error) {
+	if appearance == "" {
+		appearance = AppearanceDark
+	}
+	if !containsID(Appearances, appearance) {
+		return Theme{}, fmt.Errorf("apariencia desconocida: %s (disponibles: %s)", appearance, strings.Join(Appearances, ", "))
+	}
+	theme, err := ResolveTheme(name, appearance)
+	if err != nil {
+		return Theme{}, err
+	}
+
+	state, err := LoadState()
+	if err != nil {
+		return Theme{}, err
+	}
+	state.Theme = themeFamily(ThemeSlug(name))
+	state.Appearance = appearance
+	if err := SaveState(state); err != nil {
+		return Theme{}, err
+	}
+
+	SetActiveTheme(theme)
+	return theme, reapplyTheme(state, skip)
+}
+
+// ReapplyTheme regenera las herramientas configuradas con el tema activo
+func ReapplyTheme(state XebecState) error {
+	return reapplyTheme(state, nil)
+}
+
+// reapplyTheme regenera las herramientas configuradas salvo las de skip
+func reapplyTheme(state XebecState, skip map[string]bool) error {
+	var errs []error
+	applied := 0
+	for _, tool := range Configurators {
+		ids, ok := state.Configured[tool.ID]
+		if !ok || len(ids) == 0 {
+			continue
+		}
+		if skip[tool.ID] {
+			// Lo reconfigura otro paso, ya con el tema nuevo
+			applied++
+			continue
+		}
+		fmt.Printf("→ %s\n", tool.Name)
+		if err := tool.Apply(ids); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", tool.Name, err))
+			continue
+		}
+		applied++
+	}
+	if applied == 0 && len(errs) == 0 {
+		fmt.Println("Ninguna herramienta configurada con xebec; el tema se aplicará al configurarlas")
+	}
+	return errors.Join(errs...)
+}
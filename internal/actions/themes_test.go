@@ -0,0 +1,123 @@
+package actions
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+func TestDeriveThemeVariant(t *testing.T) {
+	dark := DefaultTheme()
+	light := deriveThemeVariant(dark, AppearanceLight)
+
+	if light.Variant != AppearanceLight {
+		t.Errorf("Variant = %s, want %s", light.Variant, AppearanceLight)
+	}
+	if light.Name != "XEBEC Light" {
+		t.Errorf("Name = %q, want %q", light.Name, "XEBEC Light")
+	}
+	if relativeLuminance(light.Background) < 0.5 {
+		t.Errorf("fondo %s no es claro", light.Background)
+	}
+	if ratio := ContrastRatio(light.Foreground, light.Background); ratio < ContrastText {
+		t.Errorf("contraste del texto %.2f:1, want >= %.1f:1", ratio, ContrastText)
+	}
+	if light.Normal.Red == dark.Normal.Red {
+		t.Errorf("normal.red %s sin oscurecer", light.Normal.Red)
+	}
+
+	// Y de vuelta a oscuro
+	if back := deriveThemeVariant(light, AppearanceDark); back.Variant != AppearanceDark || back.Name != "XEBEC Dark" {
+		t.Errorf("variante oscura = %s %q", back.Variant, back.Name)
+	}
+}
+
+func TestResolveTheme(t *testing.T) {
+	setupPlanHome(t)
+	mono, err := NewTheme(TerminalPalette{
+		Name: "Mono", Background: "#101010", Foreground: "#E0E0E0",
+		Normal: DefaultTheme().Normal, Bright: DefaultTheme().Bright,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := SaveTheme(mono, false); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name, appearance string
+		wantName         string
+		wantVariant      string
+	}{
+		{"xebec", AppearanceDark, "XEBEC", AppearanceDark},
+		{"xebec", AppearanceLight, "XEBEC Light", AppearanceLight}, // Archivo xebec-light integrado
+		{"xebec-light", AppearanceDark, "XEBEC", AppearanceDark},   // La familia, no el archivo
+		{"mono", AppearanceLight, "Mono Light", AppearanceLight},   // Derivado
+		{"Mono", AppearanceDark, "Mono", AppearanceDark},
+	}
+	for _, tt := range tests {
+		theme, err := ResolveTheme(tt.name, tt.appearance)
+		if err != nil {
+			t.Errorf("ResolveTheme(%s, %s): %v", tt.name, tt.appearance, err)
+			continue
+		}
+		if theme.Name != tt.wantName || theme.Variant != tt.wantVariant {
+			t.Errorf("ResolveTheme(%s, %s) = %q %s, want %q %s", tt.name, tt.appearance, theme.Name, theme.Variant, tt.wantName, tt.wantVariant)
+		}
+	}
+
+	if _, err := ResolveTheme("nope", AppearanceDark); err == nil {
+		t.Error("ResolveTheme con un tema desconocido debería fallar")
+	}
+	if _, err := SwitchTheme("xebec", "sepia"); err == nil {
+		t.Error("SwitchTheme con una apariencia desconocida debería fallar")
+	}
+}
+
+func TestSwitchThemeSkip(t *testing.T) {
+	setupPlanHome(t)
+	applied := map[string]int{}
+	fakeConfigurators(t, applied)
+
+	state, err := LoadState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	state.Configured = map[string][]string{"alacritty": {"colors"}, "kitty": {"colors"}}
+	if err := SaveState(state); err != nil {
+		t.Fatal(err)
+	}
+
+	theme, err := switchTheme("xebec-light", AppearanceLight, map[string]bool{"kitty": true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if theme.Name != "XEBEC Light" || XebecTheme().Name != "XEBEC Light" {
+		t.Errorf("tema activo = %q (retornado %q), want XEBEC Light", XebecTheme().Name, theme.Name)
+	}
+	if applied["alacritty"] != 1 || applied["kitty"] != 0 {
+		t.Errorf("Apply = %v, want alacritty 1 y kitty 0", applied)
+	}
+
+	// state.json guarda la familia y la apariencia, y conserva lo configurado
+	data, err := os.ReadFile(GetStatePath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var saved XebecState
+	if err := json.Unmarshal(data, &saved); err != nil {
+		t.Fatal(err)
+	}
+	if saved.Theme != "xebec" || saved.Appearance != AppearanceLight || len(saved.Configured) != 2 {
+		t.Errorf("state.json = %+v", saved)
+	}
+
+	// Un tema desconocido no toca el estado
+	if _, err := switchTheme("nope", AppearanceDark, nil); err == nil {
+		t.Fatal("switchTheme con un tema desconocido debería fallar")
+	}
+	if state, _ := LoadState(); state.Theme != "xebec" || state.Appearance != AppearanceLight {
+		t.Errorf("estado tras un error = %s %s", state.Theme, state.Appearance)
+	}
+}
@@ -0,0 +1,106 @@
+// Package: actions
+// Acciones de configuración de Tilix (esquema JSON y perfil XEBEC vía dconf)
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+)
+
+const (
+	// XebecTilixProfileUUID UUID fijo del perfil XEBEC de Tilix
+	XebecTilixProfileUUID = "5e8ec0de-7a6b-4c1d-9e2f-00aeef000003"
+	// TilixProfilesPath ruta dconf de los perfiles de Tilix
+	TilixProfilesPath = "/com/gexperts/Tilix/profiles/"
+	// tilixDefaultProfileUUID perfil que Tilix usa mientras list no está definida
+	tilixDefaultProfileUUID = "2b7c4080-0ddd-46c5-8f23-563fd3ba789d"
+)
+
+// GetTilixSchemePath retorna la ruta del esquema de colores XEBEC
+func GetTilixSchemePath() string {
+	return filepath.Join(xdgConfigHome(), "tilix", "schemes", "xebec.json")
+}
+
+// GetTilixDconfPath retorna el key file generado para `dconf load`
+func GetTilixDconfPath() string {
+	return filepath.Join(GetXebecDataDir(), "tilix", "xebec.dconf")
+}
+
+// GetTilixSourceDir retorna el directorio con las plantillas de Tilix
+func GetTilixSourceDir() string {
+	_, currentFile, _, _ := runtime.Caller(0)
+	projectRoot := filepath.Dir(filepath.Dir(filepath.Dir(currentFile)))
+	return filepath.Join(projectRoot, "tilix")
+}
+
+// ConfigureTilix instala el esquema XEBEC y carga el perfil XEBEC con dconf
+func ConfigureTilix(opts TerminalProfileOptions) (err error) {
+	defer recordConfigured("tilix", opts.modules(), &err)
+
+	sourceDir := GetTilixSourceDir()
+	data := GnomeTerminalTemplateData{ProfileTemplateData: NewProfileTemplateData(opts), UUID: XebecTilixProfileUUID}
+	if opts.Shell && data.Command == "" {
+		fmt.Println("⚠ Nushell no está instalado; el perfil usará el shell de login")
+	}
+
+	// El esquema aparece en Preferencias > Perfiles > Color
+	if opts.Colors {
+		tmpl, err := os.ReadFile(filepath.Join(sourceDir, "xebec.json"))
+		if err != nil {
+			return fmt.Errorf("error leyendo plantilla xebec.json: %w", err)
+		}
+		scheme, err := renderTemplate("xebec.json", string(tmpl), data)
+		if err != nil {
+			return fmt.Errorf("error generando configuración: %w", err)
+		}
+		if err := writeFragment(GetTilixSchemePath(), scheme); err != nil {
+			return err
+		}
+	}
+
+	tmpl, err := os.ReadFile(filepath.Join(sourceDir, "xebec.dconf"))
+	if err != nil {
+		return fmt.Errorf("error leyendo plantilla xebec.dconf: %w", err)
+	}
+	content, err := renderTemplate("xebec.dconf", string(tmpl), data)
+	if err != nil {
+		return fmt.Errorf("error generando configuración: %w", err)
+	}
+	dconfPath := GetTilixDconfPath()
+	if err := writeFragment(dconfPath, content); err != nil {
+		return err
+	}
+
+	if _, err := exec.LookPath("dconf"); err != nil {
+		fmt.Printf("⚠ dconf no está disponible; cárgalo más tarde con: dconf load %s < %s\n", TilixProfilesPath, dconfPath)
+		return nil
+	}
+	if err := loadDconfProfile(TilixProfilesPath, XebecTilixProfileUUID, tilixDefaultProfileUUID, content); err != nil {
+		return err
+	}
+	fmt.Println("✓ Perfil XEBEC cargado en Tilix")
+	return nil
+}
+
+// IsTilixInstalled verifica si Tilix está instalado
+func IsTilixInstalled() bool {
+	return isAnyBinaryInstalled([]string{"tilix"})
+}
+
+// GetTilixStatus retorna el estado actual de Tilix
+func GetTilixStatus() (installed bool, configured bool, configPath string) {
+	configPath = GetTilixDconfPath()
+	installed = IsTilixInstalled()
+
+	if _, err := os.Stat(configPath); err == nil {
+		configured = true
+	}
+
+	return
+}
@@ -0,0 +1,91 @@
+package actions
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestConfigureTilix(t *testing.T) {
+	setupPlanHome(t)
+	bin := fakeBinaries(t)
+	log, loaded := filepath.Join(bin, "dconf.log"), filepath.Join(bin, "dconf.load")
+	// dconf falso: anota cada llamada, guarda lo que recibe load y tiene un perfil previo
+	fakeScript(t, bin, "dconf", `echo "$@" >> '`+log+`'
+case "$1" in
+load) while IFS= read -r line; do echo "$line"; done > '`+loaded+`' ;;
+read) echo "['`+tilixDefaultProfileUUID+`']" ;;
+esac
+`)
+
+	opts := TerminalProfileOptions{Window: true, Colors: true, Font: true, Cursor: true}
+	if err := ConfigureTilix(opts); err != nil {
+		t.Fatal(err)
+	}
+	p := DefaultTheme().TerminalPalette
+
+	// Esquema JSON de Preferencias > Perfiles > Color
+	data, err := os.ReadFile(GetTilixSchemePath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var scheme struct {
+		Name       string   `json:"name"`
+		Foreground string   `json:"foreground-color"`
+		Background string   `json:"background-color"`
+		Palette    []string `json:"palette"`
+	}
+	if err := json.Unmarshal(data, &scheme); err != nil {
+		t.Fatalf("xebec.json no es JSON válido: %v\n%s", err, data)
+	}
+	colors := append(p.Normal.List(), p.Bright.List()...)
+	if scheme.Name != p.Name || scheme.Background != p.Background || scheme.Foreground != p.Foreground ||
+		strings.Join(scheme.Palette, ",") != strings.Join(colors, ",") {
+		t.Errorf("esquema = %+v", scheme)
+	}
+
+	// Key file del perfil XEBEC
+	data, err = os.ReadFile(GetTilixDconfPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := iniValues(string(data))
+	section := ":" + XebecTilixProfileUUID + "."
+	expectValue(t, values, section+"visible-name", "'XEBEC'")
+	expectValue(t, values, section+"background-color", "'"+p.Background+"'")
+	expectValue(t, values, section+"palette", "['"+strings.Join(colors, "', '")+"']")
+	expectValue(t, values, section+"font", "'JetBrains Mono 13'")
+	expectValue(t, values, section+"cursor-shape", "'ibeam'")
+	if _, ok := values[section+"custom-command"]; ok {
+		t.Error("sin shell no debe fijar custom-command")
+	}
+
+	// dconf: reset del perfil, load del key file y alta en la lista
+	if sent, _ := os.ReadFile(loaded); strings.TrimSpace(string(sent)) != strings.TrimSpace(string(data)) {
+		t.Errorf("dconf load recibió:\n%s", sent)
+	}
+	calls, _ := os.ReadFile(log)
+	want := "reset -f " + TilixProfilesPath + ":" + XebecTilixProfileUUID + "/\n" +
+		"load " + TilixProfilesPath + "\n" +
+		"read " + TilixProfilesPath + "list\n" +
+		"write " + TilixProfilesPath + "list ['" + tilixDefaultProfileUUID + "', '" + XebecTilixProfileUUID + "']\n"
+	if string(calls) != want {
+		t.Errorf("llamadas a dconf:\n%s\nwant\n%s", calls, want)
+	}
+}
+
+func TestConfigureTilixWithoutDconf(t *testing.T) {
+	setupPlanHome(t)
+	fakeBinaries(t)
+
+	if err := ConfigureTilix(TerminalProfileOptions{Colors: true}); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{GetTilixSchemePath(), GetTilixDconfPath()} {
+		if _, err := os.Stat(path); err != nil {
+			t.Errorf("sin dconf también se genera %s: %v", path, err)
+		}
+	}
+}
@@ -0,0 +1,622 @@
+// Package: actions
+// Lector de TOML 1.0 para validar las configuraciones generadas y las del usuario
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ParseTOML convierte un documento TOML en mapas anidados
+// Los valores son string, int64, float64, bool, []interface{} y map[string]interface{};
+// las fechas y horas se conservan como texto
+func ParseTOML(content string) (map[string]interface{}, error) {
+	p := &tomlParser{src: content, defined: map[string]bool{}}
+	root := map[string]interface{}{}
+	if err := p.parse(root); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+// tomlParser estado del lector: posición y tablas ya definidas con [cabecera]
+type tomlParser struct {
+	src        string
+	pos        int
+	defined    map[string]bool // Rutas de tablas con cabecera explícita
+	statements []tomlStatement // Sentencias de primer nivel, para editar el texto (SetTOMLValues)
+	lastKeys   []string        // Clave y rango del valor del último parseKeyValue
+	lastValue  [2]int
+}
+
+// tomlStatement cabecera o "clave = valor" de primer nivel y su posición en el texto
+type tomlStatement struct {
+	header     bool     // [tabla] o [[tabla]]
+	array      bool     // [[tabla]] o clave dentro de una
+	table      []string // Tabla de la cabecera, o de la sección donde está la clave
+	keys       []string // Clave con puntos relativa a table (vacía en cabeceras)
+	start, end int      // Desde el inicio de la línea hasta después de su fin de línea
+	valueStart int
+	valueEnd   int
+}
+
+// path ruta completa de la clave
+func (s tomlStatement) path() []string {
+	return append(append([]string{}, s.table...), s.keys...)
+}
+
+// errorf crea un error con la línea actual
+func (p *tomlParser) errorf(format string, args ...interface{}) error {
+	line := strings.Count(p.src[:min(p.pos, len(p.src))], "\n") + 1
+	return fmt.Errorf("línea %d: %s", line, fmt.Sprintf(format, args...))
+}
+
+func (p *tomlParser) eof() bool { return p.pos >= len(p.src) }
+
+func (p *tomlParser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+// skipSpace salta espacios y tabuladores
+func (p *tomlParser) skipSpace() {
+	for !p.eof() && (p.src[p.pos] == ' ' || p.src[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+// skipComment salta un comentario hasta el fin de línea
+func (p *tomlParser) skipComment() {
+	if p.peek() != '#' {
+		return
+	}
+	for !p.eof() && p.src[p.pos] != '\n' {
+		p.pos++
+	}
+}
+
+// skipBlank salta espacios, comentarios y saltos de línea
+func (p *tomlParser) skipBlank() {
+	for !p.eof() {
+		switch p.src[p.pos] {
+		case ' ', '\t', '\r', '\n':
+			p.pos++
+		case '#':
+			p.skipComment()
+		default:
+			return
+		}
+	}
+}
+
+// expectLineEnd exige que la línea termine tras un valor o una cabecera
+func (p *tomlParser) expectLineEnd() error {
+	p.skipSpace()
+	p.skipComment()
+	if p.eof() {
+		return nil
+	}
+	if strings.HasPrefix(p.src[p.pos:], "\r\n") {
+		p.pos += 2
+		return nil
+	}
+	if p.src[p.pos] == '\n' {
+		p.pos++
+		return nil
+	}
+	return p.errorf("se esperaba fin de línea y hay %q", p.rest())
+}
+
+// rest retorna lo que queda de la línea actual (para los mensajes de error)
+func (p *tomlParser) rest() string {
+	end := strings.IndexByte(p.src[p.pos:], '\n')
+	if end < 0 {
+		return p.src[p.pos:]
+	}
+	return strings.TrimRight(p.src[p.pos:p.pos+end], "\r")
+}
+
+// parse lee el documento completo
+func (p *tomlParser) parse(root map[string]interface{}) error {
+	current := root
+	var section []string
+	inArray := false
+	for {
+		p.skipBlank()
+		if p.eof() {
+			return nil
+		}
+		start := strings.LastIndexByte(p.src[:p.pos], '\n') + 1
+
+		if p.peek() == '[' {
+			array := strings.HasPrefix(p.src[p.pos:], "[[")
+			if array {
+				p.pos += 2
+			} else {
+				p.pos++
+			}
+			p.skipSpace()
+			keys, err := p.parseKey()
+			if err != nil {
+				return err
+			}
+			p.skipSpace()
+			closing := "]"
+			if array {
+				closing = "]]"
+			}
+			if !strings.HasPrefix(p.src[p.pos:], closing) {
+				return p.errorf("falta %q en la cabecera de tabla", closing)
+			}
+			p.pos += len(closing)
+			if array {
+				current, err = p.arrayTable(root, keys)
+			} else {
+				current, err = p.table(root, keys)
+			}
+			if err != nil {
+				return err
+			}
+			if err := p.expectLineEnd(); err != nil {
+				return err
+			}
+			section, inArray = keys, array
+			p.statements = append(p.statements, tomlStatement{header: true, array: array, table: keys, start: start, end: p.pos})
+			continue
+		}
+
+		if err := p.parseKeyValue(current); err != nil {
+			return err
+		}
+		if err := p.expectLineEnd(); err != nil {
+			return err
+		}
+		p.statements = append(p.statements, tomlStatement{
+			array:      inArray,
+			table:      section,
+			keys:       p.lastKeys,
+			start:      start,
+			end:        p.pos,
+			valueStart: p.lastValue[0],
+			valueEnd:   p.lastValue[1],
+		})
+	}
+}
+
+// parseKeyValue lee "clave = valor" y lo guarda en table
+func (p *tomlParser) parseKeyValue(table map[string]interface{}) error {
+	keys, err := p.parseKey()
+	if err != nil {
+		return err
+	}
+	p.skipSpace()
+	if p.peek() != '=' {
+		return p.errorf("se esperaba '=' después de %q", strings.Join(keys, "."))
+	}
+	p.pos++
+	p.skipSpace()
+	valueStart := p.pos
+	value, err := p.parseValue()
+	if err != nil {
+		return err
+	}
+	p.lastKeys, p.lastValue = keys, [2]int{valueStart, p.pos}
+
+	parent := table
+	for _, key := range keys[:len(keys)-1] {
+		switch existing := parent[key].(type) {
+		case nil:
+			child := map[string]interface{}{}
+			parent[key] = child
+			parent = child
+		case map[string]interface{}:
+			parent = existing
+		default:
+			return p.errorf("la clave %q ya tiene un valor y no puede ser una tabla", key)
+		}
+	}
+	last := keys[len(keys)-1]
+	if _, exists := parent[last]; exists {
+		return p.errorf("clave duplicada %q", strings.Join(keys, "."))
+	}
+	parent[last] = value
+	return nil
+}
+
+// parseKey lee una clave simple o con puntos (a.b."c d")
+func (p *tomlParser) parseKey() ([]string, error) {
+	var keys []string
+	for {
+		p.skipSpace()
+		var key string
+		switch c := p.peek(); {
+		case c == '"':
+			s, err := p.parseBasicString()
+			if err != nil {
+				return nil, err
+			}
+			key = s
+		case c == '\'':
+			s, err := p.parseLiteralString()
+			if err != nil {
+				return nil, err
+			}
+			key = s
+		default:
+			start := p.pos
+			for !p.eof() && isBareKeyChar(p.src[p.pos]) {
+				p.pos++
+			}
+			if start == p.pos {
+				return nil, p.errorf("se esperaba una clave y hay %q", p.rest())
+			}
+			key = p.src[start:p.pos]
+		}
+		keys = append(keys, key)
+		p.skipSpace()
+		if p.peek() != '.' {
+			return keys, nil
+		}
+		p.pos++
+	}
+}
+
+// isBareKeyChar indica si c puede ir en una clave sin comillas
+func isBareKeyChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-'
+}
+
+// table resuelve la tabla de una cabecera [a.b] y comprueba que no se defina dos veces
+func (p *tomlParser) table(root map[string]interface{}, keys []string) (map[string]interface{}, error) {
+	path := strings.Join(keys, "\x00")
+	if p.defined[path] {
+		return nil, p.errorf("la tabla [%s] está definida dos veces", strings.Join(keys, "."))
+	}
+	p.defined[path] = true
+	return p.tableNoDefine(root, keys)
+}
+
+// arrayTable añade un elemento a [[a.b]] y lo retorna
+func (p *tomlParser) arrayTable(root map[string]interface{}, keys []string) (map[string]interface{}, error) {
+	parent, err := p.tableNoDefine(root, keys[:len(keys)-1])
+	if err != nil {
+		return nil, err
+	}
+	last := keys[len(keys)-1]
+	element := map[string]interface{}{}
+	switch existing := parent[last].(type) {
+	case nil:
+		parent[last] = []interface{}{element}
+	case []interface{}:
+		if _, ok := lastTable(existing); !ok && len(existing) > 0 {
+			return nil, p.errorf("%q es un array de valores, no de tablas", last)
+		}
+		parent[last] = append(existing, element)
+	default:
+		return nil, p.errorf("la clave %q ya tiene un valor y no puede ser un array de tablas", last)
+	}
+	// Las subtablas de cada elemento pueden repetirse en el siguiente
+	prefix := strings.Join(keys, "\x00") + "\x00"
+	for path := range p.defined {
+		if strings.HasPrefix(path, prefix) {
+			delete(p.defined, path)
+		}
+	}
+	return element, nil
+}
+
+// tableNoDefine resuelve la ruta de tablas sin marcarla como definida
+// [a.b] dentro de [[a]] se refiere al último elemento del array
+func (p *tomlParser) tableNoDefine(root map[string]interface{}, keys []string) (map[string]interface{}, error) {
+	table := root
+	for _, key := range keys {
+		switch existing := table[key].(type) {
+		case nil:
+			child := map[string]interface{}{}
+			table[key] = child
+			table = child
+		case map[string]interface{}:
+			table = existing
+		case []interface{}:
+			last, ok := lastTable(existing)
+			if !ok {
+				return nil, p.errorf("%q es un array y no una tabla", key)
+			}
+			table = last
+		default:
+			return nil, p.errorf("la clave %q ya tiene un valor y no puede ser una tabla", key)
+		}
+	}
+	return table, nil
+}
+
+// lastTable retorna el último elemento de un array de tablas
+func lastTable(array []interface{}) (map[string]interface{}, bool) {
+	if len(array) == 0 {
+		return nil, false
+	}
+	table, ok := array[len(array)-1].(map[string]interface{})
+	return table, ok
+}
+
+// parseValue lee un valor de cualquier tipo
+func (p *tomlParser) parseValue() (interface{}, error) {
+	switch c := p.peek(); {
+	case c == 0:
+		return nil, p.errorf("falta el valor")
+	case strings.HasPrefix(p.src[p.pos:], `"""`):
+		return p.parseMultilineString(`"""`)
+	case strings.HasPrefix(p.src[p.pos:], "'''"):
+		return p.parseMultilineString("'''")
+	case c == '"':
+		return p.parseBasicString()
+	case c == '\'':
+		return p.parseLiteralString()
+	case c == '[':
+		return p.parseArray()
+	case c == '{':
+		return p.parseInlineTable()
+	case strings.HasPrefix(p.src[p.pos:], "true"):
+		p.pos += 4
+		return true, nil
+	case strings.HasPrefix(p.src[p.pos:], "false"):
+		p.pos += 5
+		return false, nil
+	}
+	return p.parseNumberOrDate()
+}
+
+// parseBasicString lee "texto" con escapes
+func (p *tomlParser) parseBasicString() (string, error) {
+	p.pos++ // "
+	var out strings.Builder
+	for {
+		if p.eof() || p.src[p.pos] == '\n' {
+			return "", p.errorf("texto sin cerrar")
+		}
+		c := p.src[p.pos]
+		switch c {
+		case '"':
+			p.pos++
+			return out.String(), nil
+		case '\\':
+			if err := p.parseEscape(&out); err != nil {
+				return "", err
+			}
+		default:
+			out.WriteByte(c)
+			p.pos++
+		}
+	}
+}
+
+// parseLiteralString lee 'texto' sin escapes
+func (p *tomlParser) parseLiteralString() (string, error) {
+	p.pos++ // '
+	end := strings.IndexAny(p.src[p.pos:], "'\n")
+	if end < 0 || p.src[p.pos+end] == '\n' {
+		return "", p.errorf("texto sin cerrar")
+	}
+	s := p.src[p.pos : p.pos+end]
+	p.pos += end + 1
+	return s, nil
+}
+
+// parseMultilineString lee """texto""" o ”'texto”'
+func (p *tomlParser) parseMultilineString(delim string) (string, error) {
+	p.pos += 3
+	// El salto de línea justo después del delimitador no forma parte del texto
+	if strings.HasPrefix(p.src[p.pos:], "\r\n") {
+		p.pos += 2
+	} else if p.peek() == '\n' {
+		p.pos++
+	}
+
+	var out strings.Builder
+	for {
+		if p.eof() {
+			return "", p.errorf("texto multilínea sin cerrar")
+		}
+		if strings.HasPrefix(p.src[p.pos:], delim) {
+			// Hasta dos comillas extra antes del cierre pertenecen al texto
+			extra := 0
+			for extra < 2 && p.pos+3+extra < len(p.src) && p.src[p.pos+3+extra] == delim[0] {
+				extra++
+			}
+			out.WriteString(p.src[p.pos : p.pos+extra])
+			p.pos += 3 + extra
+			return out.String(), nil
+		}
+		c := p.src[p.pos]
+		if c == '\\' && delim == `"""` {
+			// Barra al final de la línea: se omiten el salto y los espacios siguientes
+			rest := strings.TrimLeft(p.src[p.pos+1:], " \t")
+			if strings.HasPrefix(rest, "\n") || strings.HasPrefix(rest, "\r\n") {
+				p.pos = len(p.src) - len(rest)
+				for !p.eof() && strings.IndexByte(" \t\r\n", p.src[p.pos]) >= 0 {
+					p.pos++
+				}
+				continue
+			}
+			if err := p.parseEscape(&out); err != nil {
+				return "", err
+			}
+			continue
+		}
+		out.WriteByte(c)
+		p.pos++
+	}
+}
+
+// parseEscape traduce una secuencia \x de un texto entre comillas dobles
+func (p *tomlParser) parseEscape(out *strings.Builder) error {
+	if p.pos+1 >= len(p.src) {
+		return p.errorf("escape incompleto")
+	}
+	c := p.src[p.pos+1]
+	p.pos += 2
+	switch c {
+	case 'b':
+		out.WriteByte('\b')
+	case 't':
+		out.WriteByte('\t')
+	case 'n':
+		out.WriteByte('\n')
+	case 'f':
+		out.WriteByte('\f')
+	case 'r':
+		out.WriteByte('\r')
+	case 'e':
+		out.WriteByte(0x1b)
+	case '"':
+		out.WriteByte('"')
+	case '\\':
+		out.WriteByte('\\')
+	case 'u', 'U':
+		size := 4
+		if c == 'U' {
+			size = 8
+		}
+		if p.pos+size > len(p.src) {
+			return p.errorf("escape \\%c incompleto", c)
+		}
+		code, err := strconv.ParseUint(p.src[p.pos:p.pos+size], 16, 32)
+		if err != nil || !utf8.ValidRune(rune(code)) {
+			return p.errorf("escape \\%c%s no válido", c, p.src[p.pos:p.pos+size])
+		}
+		out.WriteRune(rune(code))
+		p.pos += size
+	default:
+		return p.errorf("escape \\%c no válido (en rutas de Windows usa comillas simples)", c)
+	}
+	return nil
+}
+
+// parseArray lee [a, b, c]; puede ocupar varias líneas y llevar comentarios
+func (p *tomlParser) parseArray() ([]interface{}, error) {
+	p.pos++ // [
+	values := []interface{}{}
+	for {
+		p.skipBlank()
+		if p.peek() == ']' {
+			p.pos++
+			return values, nil
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		p.skipBlank()
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ']':
+			p.pos++
+			return values, nil
+		default:
+			return nil, p.errorf("se esperaba ',' o ']' en el array")
+		}
+	}
+}
+
+// parseInlineTable lee { a = 1, b.c = "x" } en una sola línea
+func (p *tomlParser) parseInlineTable() (map[string]interface{}, error) {
+	p.pos++ // {
+	table := map[string]interface{}{}
+	p.skipSpace()
+	if p.peek() == '}' {
+		p.pos++
+		return table, nil
+	}
+	for {
+		p.skipSpace()
+		if err := p.parseKeyValue(table); err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case '}':
+			p.pos++
+			return table, nil
+		default:
+			return nil, p.errorf("se esperaba ',' o '}' en la tabla en línea")
+		}
+	}
+}
+
+// Formatos de fecha y hora de TOML (se conservan como texto)
+var (
+	tomlDateRe = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	tomlTimeRe = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2}[Tt ])?\d{2}:\d{2}(:\d{2}(\.\d+)?)?([Zz]|[+-]\d{2}:\d{2})?$`)
+)
+
+// parseNumberOrDate lee enteros, decimales, inf, nan, fechas y horas
+func (p *tomlParser) parseNumberOrDate() (interface{}, error) {
+	start := p.pos
+	for !p.eof() && strings.IndexByte("0123456789abcdefABCDEFxXoOinINtTzZ_+-.:", p.src[p.pos]) >= 0 {
+		p.pos++
+	}
+	token := p.src[start:p.pos]
+	// Fecha y hora separadas por un espacio
+	if tomlDateRe.MatchString(token) && p.pos+1 < len(p.src) && p.src[p.pos] == ' ' && p.src[p.pos+1] >= '0' && p.src[p.pos+1] <= '9' {
+		p.pos++
+		for !p.eof() && strings.IndexByte("0123456789zZ+-.:", p.src[p.pos]) >= 0 {
+			p.pos++
+		}
+		token = p.src[start:p.pos]
+	}
+
+	switch {
+	case token == "":
+		return nil, p.errorf("valor no válido: %q", p.rest())
+	case tomlDateRe.MatchString(token) || tomlTimeRe.MatchString(token):
+		return token, nil
+	case token == "inf" || token == "+inf" || token == "-inf" || token == "nan" || token == "+nan" || token == "-nan":
+		f, _ := strconv.ParseFloat(strings.TrimPrefix(token, "+"), 64)
+		return f, nil
+	}
+
+	if strings.HasPrefix(token, "0x") || strings.HasPrefix(token, "0o") || strings.HasPrefix(token, "0b") {
+		n, err := strconv.ParseInt(token, 0, 64)
+		if err != nil {
+			return nil, p.errorf("número no válido: %s", token)
+		}
+		return n, nil
+	}
+
+	// Los _ solo pueden ir entre dígitos
+	if strings.Contains(token, "__") || strings.HasPrefix(strings.TrimLeft(token, "+-"), "_") || strings.HasSuffix(token, "_") {
+		return nil, p.errorf("número no válido: %s", token)
+	}
+	digits := strings.ReplaceAll(token, "_", "")
+	unsigned := strings.TrimLeft(digits, "+-")
+	if len(unsigned) > 1 && unsigned[0] == '0' && unsigned[1] >= '0' && unsigned[1] <= '9' {
+		return nil, p.errorf("número con ceros a la izquierda: %s", token)
+	}
+	if !strings.ContainsAny(unsigned, ".eE") {
+		n, err := strconv.ParseInt(digits, 10, 64)
+		if err != nil {
+			return nil, p.errorf("número no válido: %s", token)
+		}
+		return n, nil
+	}
+	if strings.HasPrefix(unsigned, ".") || strings.HasSuffix(unsigned, ".") || strings.Contains(unsigned, ".e") || strings.Contains(unsigned, ".E") {
+		return nil, p.errorf("número no válido: %s", token)
+	}
+	f, err := strconv.ParseFloat(digits, 64)
+	if err != nil {
+		return nil, p.errorf("número no válido: %s", token)
+	}
+	return f, nil
+}
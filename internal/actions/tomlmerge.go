@@ -0,0 +1,189 @@
+// Package: actions
+// Edición de archivos TOML del usuario conservando comentarios, orden y formato
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// scanTOML lee el documento y retorna sus sentencias de primer nivel
+func scanTOML(content string) ([]tomlStatement, error) {
+	p := &tomlParser{src: content, defined: map[string]bool{}}
+	if err := p.parse(map[string]interface{}{}); err != nil {
+		return nil, err
+	}
+	return p.statements, nil
+}
+
+// SetTOMLValues fija en content las claves de un TOML renderizado
+// Reemplaza el valor donde ya esté la clave (en su tabla o con puntos) y si no la añade
+// a su tabla; el resto del archivo queda igual. Falla en lugar de generar TOML inválido
+func SetTOMLValues(content, rendered string) (string, error) {
+	values, err := scanTOML(rendered)
+	if err != nil {
+		return "", fmt.Errorf("plantilla TOML inválida: %w", err)
+	}
+	if _, err := scanTOML(content); err != nil {
+		return "", fmt.Errorf("TOML inválido: %w", err)
+	}
+	for _, v := range values {
+		if v.header {
+			continue
+		}
+		if v.array {
+			return "", fmt.Errorf("plantilla TOML: las claves de [[%s]] no se pueden fusionar", strings.Join(v.table, "."))
+		}
+		if content, err = setTOMLValue(content, v.path(), rendered[v.valueStart:v.valueEnd]); err != nil {
+			return "", err
+		}
+	}
+	if _, err := ParseTOML(content); err != nil {
+		return "", fmt.Errorf("la fusión generaría TOML inválido: %w", err)
+	}
+	return content, nil
+}
+
+// setTOMLValue fija una clave (ruta completa) con el valor ya formateado
+func setTOMLValue(content string, path []string, value string) (string, error) {
+	stmts, err := scanTOML(content)
+	if err != nil {
+		return "", err
+	}
+	name := strings.Join(path, ".")
+	parent := path[:len(path)-1]
+
+	// anchor: última sentencia de la tabla de la clave, para insertar detrás
+	var anchor *tomlStatement
+	firstHeader := -1
+	for i := range stmts {
+		s := &stmts[i]
+		if s.header && firstHeader < 0 {
+			firstHeader = s.start
+		}
+		if s.array {
+			continue
+		}
+		if s.header {
+			if equalKeys(s.table, parent) {
+				anchor = s
+			}
+			continue
+		}
+		full := s.path()
+		switch {
+		case equalKeys(full, path):
+			return content[:s.valueStart] + value + content[s.valueEnd:], nil
+		case hasKeyPrefix(path, full):
+			return "", fmt.Errorf("%s tiene un valor en línea; pásalo a una tabla [%s] para que xebec pueda fijar %s", strings.Join(full, "."), strings.Join(full, "."), name)
+		case hasKeyPrefix(full, path):
+			return "", fmt.Errorf("%s ya es una tabla y no puede tener un valor", name)
+		case hasKeyPrefix(full, parent) && hasKeyPrefix(parent, s.table):
+			anchor = s
+		}
+	}
+
+	eol := eolOf(content)
+	if anchor != nil {
+		line := tomlKeyPath(path[len(anchor.table):]) + " = " + value + eol
+		at := anchor.end
+		if at == len(content) && !strings.HasSuffix(content, "\n") {
+			line = eol + line
+		}
+		return content[:at] + line + content[at:], nil
+	}
+
+	line := tomlKeyPath(path[len(path)-1:]) + " = " + value + eol
+	if len(parent) == 0 {
+		// Clave de la raíz sin otras claves de raíz: antes de la primera tabla
+		if firstHeader >= 0 {
+			return content[:firstHeader] + line + eol + content[firstHeader:], nil
+		}
+		if content != "" && !strings.HasSuffix(content, "\n") {
+			content += eol
+		}
+		return content + line, nil
+	}
+
+	// Tabla nueva al final del archivo
+	if content != "" {
+		if !strings.HasSuffix(content, "\n") {
+			content += eol
+		}
+		content += eol
+	}
+	return content + "[" + tomlKeyPath(parent) + "]" + eol + line, nil
+}
+
+// tomlKeyPath formatea una clave con puntos, con comillas solo donde hacen falta
+func tomlKeyPath(keys []string) string {
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		bare := key != ""
+		for j := 0; j < len(key); j++ {
+			bare = bare && isBareKeyChar(key[j])
+		}
+		if bare {
+			parts[i] = key
+		} else {
+			parts[i] = tomlBasicString(key)
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// tomlBasicString cita un texto como cadena básica de TOML
+func tomlBasicString(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+	return `"` + r.Replace(s) + `"`
+}
+
+// equalKeys compara dos rutas de claves
+func equalKeys(a, b []string) bool {
+	return len(a) == len(b) && hasKeyPrefix(a, b)
+}
+
+// hasKeyPrefix indica si la ruta keys empieza por prefix
+func hasKeyPrefix(keys, prefix []string) bool {
+	if len(prefix) > len(keys) {
+		return false
+	}
+	for i := range prefix {
+		if keys[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeTOMLFile fija en path las claves de un TOML renderizado, con backup si cambia
+func mergeTOMLFile(path, rendered string) error {
+	current, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error leyendo %s: %w", path, err)
+	}
+	updated, err := SetTOMLValues(string(current), rendered)
+	if err != nil {
+		return fmt.Errorf("error fusionando %s: %w", path, err)
+	}
+	if updated == string(current) {
+		trackWrite(path, updated)
+		fmt.Printf("• %s ya está actualizado\n", path)
+		return nil
+	}
+
+	var result BlockResult
+	if err := writeWithBackup(path, updated, &result); err != nil {
+		return err
+	}
+	trackWrite(path, updated)
+	if result.BackupPath != "" {
+		fmt.Printf("✓ Backup creado: %s\n", result.BackupPath)
+	}
+	fmt.Printf("✓ Configuración aplicada: %s\n", path)
+	return nil
+}
@@ -0,0 +1,117 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const rioRendered = `theme = 'xebec'
+padding-x = 0
+
+[window]
+opacity = 0.85
+
+[fonts]
+family = 'JetBrains Mono'
+size = 13
+
+[cursor]
+shape = 'beam'
+blinking = true
+
+[shell]
+program = '/usr/bin/nu'
+args = ['--login']
+`
+
+func TestSetTOMLValuesRioConfig(t *testing.T) {
+	current, err := os.ReadFile(filepath.Join("testdata", "rio", "config.toml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	merged, err := SetTOMLValues(string(current), rioRendered)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := ParseTOML(merged)
+	if err != nil {
+		t.Fatalf("resultado inválido: %v\n%s", err, merged)
+	}
+
+	tests := []struct {
+		path string
+		want interface{}
+	}{
+		{"theme", "xebec"},
+		{"padding-x", int64(0)},
+		{"cursor.shape", "beam"},
+		{"cursor.blinking", true},
+		{"window.opacity", 0.85},
+		{"window.width", int64(1200)},
+		{"window.colors.background", "#000000"},
+		{"fonts.family", "JetBrains Mono"},
+		{"fonts.size", int64(13)},
+		{"fonts.features", []interface{}{"ss01", "ss02"}},
+		{"fonts.regular.family", "Fira Code"},
+		{"shell.program", "/usr/bin/nu"},
+		{"shell.args", []interface{}{"--login"}},
+		{"env-vars", []interface{}{"TERM=xterm-256color", "COLORTERM=truecolor"}},
+		{"renderer.performance", "High"},
+	}
+	for _, tt := range tests {
+		var got interface{} = doc
+		for _, key := range strings.Split(tt.path, ".") {
+			table, _ := got.(map[string]interface{})
+			got = table[key]
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s = %#v, want %#v", tt.path, got, tt.want)
+		}
+	}
+	if rules, _ := doc["hints"].(map[string]interface{})["rules"].([]interface{}); len(rules) != 1 {
+		t.Errorf("hints.rules = %#v", doc["hints"])
+	}
+	for _, keep := range []string{"# Configuración de Rio del usuario", "# clave con puntos en la raíz"} {
+		if !strings.Contains(merged, keep) {
+			t.Errorf("se perdió %q", keep)
+		}
+	}
+
+	again, err := SetTOMLValues(merged, rioRendered)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again != merged {
+		t.Errorf("la segunda fusión cambió config.toml:\n%s", UnifiedDiff(merged, again, "primera", "segunda"))
+	}
+}
+
+func TestSetTOMLValuesEmpty(t *testing.T) {
+	merged, err := SetTOMLValues("", rioRendered)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseTOML(merged); err != nil {
+		t.Fatalf("resultado inválido: %v\n%s", err, merged)
+	}
+}
+
+func TestSetTOMLValuesErrors(t *testing.T) {
+	tests := []struct {
+		name, current string
+	}{
+		{"tabla en línea", "window = { opacity = 1.0 }\n"},
+		{"valor que ya es tabla", "[cursor.shape]\nkind = 'block'\n"},
+		{"TOML inválido", "[window\nopacity = 1.0\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := SetTOMLValues(tt.current, rioRendered); err == nil {
+				t.Errorf("se esperaba error y se obtuvo:\n%s", got)
+			}
+		})
+	}
+}
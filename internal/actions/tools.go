@@ -0,0 +1,213 @@
+// Package: actions
+// Instalación de herramientas (fzf, zoxide, bat, delta, eza) con el gestor de paquetes del sistema
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strings"
+)
+
+// Tool herramienta de línea de comandos que XEBEC instala
+type Tool struct {
+	ID       string
+	Name     string
+	Binaries []string          // Ejecutables que indican que ya está instalada
+	Packages map[string]string // Gestor -> paquete, si difiere del ID
+}
+
+// Tools herramientas del submenú "Instalar Herramientas"
+var Tools = []Tool{
+	{ID: "fzf", Name: "fzf", Binaries: []string{"fzf"}, Packages: map[string]string{"winget": "junegunn.fzf"}},
+	{ID: "zoxide", Name: "zoxide", Binaries: []string{"zoxide"}, Packages: map[string]string{"winget": "ajeetdsouza.zoxide"}},
+	// Debian y Ubuntu instalan bat como batcat
+	{ID: "bat", Name: "bat", Binaries: []string{"bat", "batcat"}, Packages: map[string]string{"winget": "sharkdp.bat"}},
+	{ID: "delta", Name: "delta", Binaries: []string{"delta"}, Packages: map[string]string{
+		"apt": "git-delta", "dnf": "git-delta", "pacman": "git-delta", "zypper": "git-delta", "brew": "git-delta", "winget": "dandavison.delta",
+	}},
+	{ID: "eza", Name: "eza", Binaries: []string{"eza"}, Packages: map[string]string{"winget": "eza-community.eza"}},
+}
+
+// PackageManager gestor de paquetes del sistema
+type PackageManager struct {
+	ID        string
+	Binary    string
+	NeedsRoot bool
+	Install   func(pkg string) []string // Argumentos tras el binario
+	// InstallVersion argumentos para instalar una versión exacta; nil si el gestor no permite fijarla
+	InstallVersion func(pkg, version string) []string
+	Query          func(pkg string) []string // Comando completo que termina bien si el paquete está instalado
+}
+
+// packageManagers gestores soportados, en orden de preferencia por sistema
+var packageManagers = map[string][]PackageManager{
+	"windows": {
+		{ID: "winget", Binary: "winget", Install: func(pkg string) []string {
+			return []string{"install", "--id", pkg, "--exact", "--silent", "--accept-source-agreements", "--accept-package-agreements"}
+		}, InstallVersion: func(pkg, version string) []string {
+			return []string{"install", "--id", pkg, "--exact", "--version", version, "--silent", "--accept-source-agreements", "--accept-package-agreements"}
+		}, Query: func(pkg string) []string { return []string{"winget", "list", "--id", pkg, "--exact"} }},
+		{ID: "scoop", Binary: "scoop", Install: func(pkg string) []string { return []string{"install", pkg} },
+			InstallVersion: func(pkg, version string) []string { return []string{"install", pkg + "@" + version} },
+			Query:          func(pkg string) []string { return []string{"scoop", "prefix", pkg} }},
+	},
+	"darwin": {
+		{ID: "brew", Binary: "brew", Install: func(pkg string) []string { return []string{"install", pkg} }, Query: brewQuery},
+	},
+	"linux": {
+		{ID: "apt", Binary: "apt-get", NeedsRoot: true, Install: func(pkg string) []string { return []string{"install", "-y", pkg} },
+			Query: func(pkg string) []string { return []string{"dpkg-query", "-W", pkg} }},
+		{ID: "dnf", Binary: "dnf", NeedsRoot: true, Install: func(pkg string) []string { return []string{"install", "-y", pkg} },
+			InstallVersion: func(pkg, version string) []string { return []string{"install", "-y", pkg + "-" + version} }, Query: rpmQuery},
+		{ID: "pacman", Binary: "pacman", NeedsRoot: true, Install: func(pkg string) []string { return []string{"-S", "--needed", "--noconfirm", pkg} },
+			Query: func(pkg string) []string { return []string{"pacman", "-Q", pkg} }},
+		{ID: "zypper", Binary: "zypper", NeedsRoot: true, Install: func(pkg string) []string { return []string{"install", "-y", pkg} }, Query: rpmQuery},
+		{ID: "brew", Binary: "brew", Install: func(pkg string) []string { return []string{"install", pkg} }, Query: brewQuery},
+	},
+}
+
+// rpmQuery consulta un paquete en sistemas con rpm (dnf, zypper)
+func rpmQuery(pkg string) []string { return []string{"rpm", "-q", pkg} }
+
+// brewQuery consulta una fórmula de Homebrew
+func brewQuery(pkg string) []string { return []string{"brew", "list", "--versions", pkg} }
+
+// NonInteractive sin terminal no se puede pedir la contraseña: sudo usa -n y falla en lugar de esperar
+var NonInteractive bool
+
+// sudoArgs antepone sudo a un comando (sudo -n sin terminal)
+func sudoArgs(args ...string) []string {
+	if NonInteractive {
+		return append([]string{"sudo", "-n"}, args...)
+	}
+	return append([]string{"sudo"}, args...)
+}
+
+// DetectPackageManager retorna el primer gestor de paquetes disponible
+func DetectPackageManager() (PackageManager, bool) {
+	for _, pm := range packageManagers[runtime.GOOS] {
+		if _, err := exec.LookPath(pm.Binary); err == nil {
+			return pm, true
+		}
+	}
+	return PackageManager{}, false
+}
+
+// FindTool busca una herramienta por ID (fzf) o por ID de menú (tools_fzf)
+func FindTool(id string) (Tool, bool) {
+	id = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(id)), "tools_")
+	for _, t := range Tools {
+		if t.ID == id {
+			return t, true
+		}
+	}
+	return Tool{}, false
+}
+
+// IsInstalled verifica si alguno de los ejecutables de la herramienta está en PATH
+func (t Tool) IsInstalled() bool {
+	return isAnyBinaryInstalled(t.Binaries)
+}
+
+// packageFor retorna el nombre del paquete en un gestor
+func (t Tool) packageFor(pm PackageManager) string {
+	if pkg, ok := t.Packages[pm.ID]; ok {
+		return pkg
+	}
+	return t.ID
+}
+
+// versionRe primer número de versión en la salida de --version
+var versionRe = regexp.MustCompile(`\d+(\.\d+)+`)
+
+// Version retorna la versión instalada según "<binario> --version"
+func (t Tool) Version() string {
+	for _, bin := range t.Binaries {
+		out, err := exec.Command(bin, "--version").Output()
+		if err != nil {
+			continue
+		}
+		return versionRe.FindString(string(out))
+	}
+	return ""
+}
+
+// Source retorna el gestor de paquetes que instaló la herramienta y el paquete
+// Si ninguno la reconoce se asume instalada a mano ("path")
+func (t Tool) Source() (string, string) {
+	for _, pm := range packageManagers[runtime.GOOS] {
+		if pm.Query == nil {
+			continue
+		}
+		if _, err := exec.LookPath(pm.Binary); err != nil {
+			continue
+		}
+		pkg := t.packageFor(pm)
+		args := pm.Query(pkg)
+		if exec.Command(args[0], args[1:]...).Run() == nil {
+			return pm.ID, pkg
+		}
+	}
+	return "path", ""
+}
+
+// InstallTool instala una herramienta con el gestor de paquetes del sistema
+// Si ya está instalada no hace nada
+func InstallTool(t Tool) error {
+	return InstallToolVersion(t, "")
+}
+
+// InstallToolVersion instala la versión de xebec.lock si el gestor permite fijarla
+// (winget, scoop, dnf); los demás instalan la que tengan y apply la compara con el lock al terminar
+func InstallToolVersion(t Tool, version string) error {
+	if t.IsInstalled() {
+		fmt.Printf("• %s ya está instalado\n", t.Name)
+		return nil
+	}
+	pm, ok := DetectPackageManager()
+	if !ok {
+		return fmt.Errorf("no se encontró un gestor de paquetes compatible para instalar %s", t.Name)
+	}
+
+	args := append([]string{pm.Binary}, pm.Install(t.packageFor(pm))...)
+	if version != "" {
+		if pm.InstallVersion != nil {
+			args = append([]string{pm.Binary}, pm.InstallVersion(t.packageFor(pm), version)...)
+		} else {
+			fmt.Printf("• %s no permite fijar la versión: se instala la disponible en lugar de %s %s\n", pm.ID, t.Name, version)
+		}
+	}
+	if pm.NeedsRoot && os.Geteuid() != 0 {
+		if _, err := exec.LookPath("sudo"); err != nil {
+			return fmt.Errorf("%s requiere permisos de administrador y no se encontró sudo", pm.Binary)
+		}
+		args = sudoArgs(args...)
+	}
+
+	fmt.Printf("→ %s\n", strings.Join(args, " "))
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("error instalando %s con %s: %w", t.Name, pm.ID, err)
+	}
+	fmt.Printf("✓ %s instalado\n", t.Name)
+	return nil
+}
+
+// InstallTools instala varias herramientas; los errores se acumulan sin detener el resto
+func InstallTools(tools []Tool) error {
+	var errs []error
+	for _, t := range tools {
+		if err := InstallTool(t); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
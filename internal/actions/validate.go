@@ -0,0 +1,351 @@
+// Package: actions
+// Validación de la configuración generada antes de escribirla
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/XebecCorporation/XebecCorporation.Dots/schemas"
+)
+
+// Gravedad de un problema de configuración
+const (
+	IssueError   = "error"
+	IssueWarning = "warning"
+)
+
+// ForceConfigWrite escribe la configuración aunque no sea válida (--force)
+var ForceConfigWrite bool
+
+// ConfigIssue problema encontrado al validar una configuración
+type ConfigIssue struct {
+	Severity string `json:"severity"`
+	Key      string `json:"key,omitempty"` // Ruta con puntos; vacía en errores de sintaxis
+	Message  string `json:"message"`
+	Requires string `json:"requires,omitempty"` // Versión de la herramienta que lo entiende, si el problema es la versión
+}
+
+// String formatea el problema para mostrarlo
+func (i ConfigIssue) String() string {
+	if i.Key == "" {
+		return i.Message
+	}
+	return i.Key + ": " + i.Message
+}
+
+// toolSchema opciones conocidas de una herramienta (schemas/<id>.json)
+type toolSchema struct {
+	Tool       string                      `json:"tool"`
+	Format     string                      `json:"format"`
+	Min        string                      `json:"min"` // Primera versión que entiende este formato de archivo
+	Keys       map[string]schemaKey        `json:"keys"`
+	Deprecated map[string]schemaDeprecated `json:"deprecated"`
+}
+
+// schemaKey opción conocida
+type schemaKey struct {
+	Type  string `json:"type"`  // string, bool, int, float, array, table o any; alternativas con |
+	Since string `json:"since"` // Primera versión que la entiende
+	Open  bool   `json:"open"`  // Tabla con claves libres (env, colores normal/bright)
+}
+
+// schemaDeprecated opción obsoleta y su sustituta
+type schemaDeprecated struct {
+	Since string `json:"since"` // Versión desde la que está obsoleta
+	Use   string `json:"use"`
+}
+
+var (
+	loadedSchemas = map[string]*toolSchema{}
+	toolVersions  = map[string]string{}
+)
+
+// loadSchema lee el esquema embebido de una herramienta
+func loadSchema(tool string) (*toolSchema, error) {
+	if schema, ok := loadedSchemas[tool]; ok {
+		return schema, nil
+	}
+	data, err := schemas.Builtin.ReadFile(tool + ".json")
+	if err != nil {
+		return nil, fmt.Errorf("error leyendo esquema de %s: %w", tool, err)
+	}
+	var schema toolSchema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("error leyendo esquema de %s: %w", tool, err)
+	}
+	loadedSchemas[tool] = &schema
+	return &schema, nil
+}
+
+// installedToolVersion versión instalada de una herramienta; vacía si no se puede saber
+func installedToolVersion(tool string) string {
+	if version, ok := toolVersions[tool]; ok {
+		return version
+	}
+	version := ""
+	if out, err := exec.Command(tool, "--version").Output(); err == nil {
+		version = versionRe.FindString(string(out))
+	}
+	toolVersions[tool] = version
+	return version
+}
+
+// configFormatFor formato del archivo y herramienta con esquema; vacíos si no se valida
+func configFormatFor(path string) (format, tool string) {
+	switch path {
+	case GetAlacrittyConfigPath():
+		return "toml", "alacritty"
+	case GetStarshipConfigPath():
+		return "toml", "starship"
+	case GetRioConfigPath(), GetRioThemePath():
+		return "toml", "" // Solo sintaxis: sin esquema de Rio
+	case GetKittyXebecPath():
+		return "kitty", "" // Solo sintaxis: "opción valor" por línea
+	case GetGhosttyXebecPath():
+		return "ghostty", "" // Solo sintaxis: "opción = valor" por línea
+	}
+	if wt := GetWindowsTerminalSettingsPath(); wt != "" && path == wt {
+		return "jsonc", "" // Solo sintaxis
+	}
+	return "", ""
+}
+
+// ValidateConfig comprueba la sintaxis del archivo y, si hay esquema, sus claves
+// Con la versión instalada de la herramienta se detectan opciones que aún no entiende
+func ValidateConfig(path, content string) []ConfigIssue {
+	format, tool := configFormatFor(path)
+	if format == "" {
+		return nil
+	}
+	var config map[string]interface{}
+	var err error
+	switch format {
+	case "toml":
+		config, err = ParseTOML(content)
+	case "kitty":
+		err = parseKittyConf(content)
+	case "ghostty":
+		err = parseGhosttyConf(content)
+	default:
+		err = parseJSONC([]byte(content))
+	}
+	if err != nil {
+		return []ConfigIssue{{Severity: IssueError, Message: err.Error()}}
+	}
+	if tool == "" {
+		return nil
+	}
+	schema, err := loadSchema(tool)
+	if err != nil {
+		return []ConfigIssue{{Severity: IssueError, Message: err.Error()}}
+	}
+
+	version := installedToolVersion(tool)
+	if schema.Min != "" && version != "" && !versionAtLeast(version, schema.Min) {
+		return []ConfigIssue{{
+			Severity: IssueError,
+			Message:  fmt.Sprintf("%s %s no entiende %s (requiere %s)", schema.Tool, version, filepath.Base(path), schema.Min),
+			Requires: schema.Min,
+		}}
+	}
+
+	var issues []ConfigIssue
+	schema.check(config, "", version, &issues)
+	sort.Slice(issues, func(a, b int) bool { return issues[a].Key < issues[b].Key })
+	return issues
+}
+
+// parseJSONC lee un JSON con comentarios e indica la línea de los errores
+func parseJSONC(data []byte) error {
+	var v interface{}
+	stripped := StripJSONC(data)
+	if err := json.Unmarshal(stripped, &v); err != nil {
+		if syntax, ok := err.(*json.SyntaxError); ok {
+			line := strings.Count(string(stripped[:min(int(syntax.Offset), len(stripped))]), "\n") + 1
+			return fmt.Errorf("línea %d: %v", line, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// confKeyRe nombre de opción de kitty (font_size) o Ghostty (font-size)
+var confKeyRe = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// confColorRe color #RRGGBB (o #RGB) de kitty y Ghostty
+var confColorRe = regexp.MustCompile(`^#([0-9A-Fa-f]{3}|[0-9A-Fa-f]{6})$`)
+
+// parseKittyConf comprueba que cada línea de kitty.conf sea "opción valor"
+func parseKittyConf(content string) error {
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, _ := strings.Cut(line, " ")
+		value = strings.TrimSpace(value)
+		if !confKeyRe.MatchString(key) || strings.Contains(key, "-") {
+			return fmt.Errorf("línea %d: opción no válida %q", i+1, key)
+		}
+		if value == "" {
+			return fmt.Errorf("línea %d: %s sin valor", i+1, key)
+		}
+		if strings.HasPrefix(value, "#") && !confColorRe.MatchString(value) {
+			return fmt.Errorf("línea %d: %s: color no válido %q", i+1, key, value)
+		}
+	}
+	return nil
+}
+
+// parseGhosttyConf comprueba que cada línea de la configuración de Ghostty sea "opción = valor"
+// Un valor vacío es válido: restablece la opción
+func parseGhosttyConf(content string) error {
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if !ok {
+			return fmt.Errorf("línea %d: falta '=' en %q", i+1, line)
+		}
+		if !confKeyRe.MatchString(key) || strings.Contains(key, "_") {
+			return fmt.Errorf("línea %d: opción no válida %q", i+1, key)
+		}
+		if key == "palette" {
+			n, color, _ := strings.Cut(value, "=")
+			if index, err := strconv.Atoi(n); err != nil || index < 0 || index > 255 || !confColorRe.MatchString(color) {
+				return fmt.Errorf("línea %d: palette espera <0-255>=#RRGGBB y es %q", i+1, value)
+			}
+		} else if strings.HasPrefix(value, "#") && !confColorRe.MatchString(value) {
+			return fmt.Errorf("línea %d: %s: color no válido %q", i+1, key, value)
+		}
+	}
+	return nil
+}
+
+// check recorre una tabla comparando cada clave con el esquema
+func (s *toolSchema) check(table map[string]interface{}, prefix, version string, issues *[]ConfigIssue) {
+	for name, value := range table {
+		key := name
+		if prefix != "" {
+			key = prefix + "." + name
+		}
+
+		// Las opciones obsoletas solo avisan si la versión instalada ya tiene la sustituta
+		if dep, ok := s.Deprecated[key]; ok {
+			if version == "" || versionAtLeast(version, dep.Since) {
+				*issues = append(*issues, ConfigIssue{
+					Severity: IssueWarning,
+					Key:      key,
+					Message:  fmt.Sprintf("obsoleta desde %s %s, usa %s", s.Tool, dep.Since, dep.Use),
+				})
+			}
+			continue
+		}
+
+		spec, ok := s.Keys[key]
+		if !ok {
+			// "*" (o "tabla.*") cubre las claves libres: los módulos de Starship
+			spec, ok = s.Keys[strings.TrimPrefix(prefix+".*", ".")]
+		}
+		if !ok {
+			if sub, isTable := value.(map[string]interface{}); isTable && s.hasChildren(key) {
+				s.check(sub, key, version, issues)
+				continue
+			}
+			*issues = append(*issues, ConfigIssue{Severity: IssueError, Key: key, Message: "opción desconocida"})
+			continue
+		}
+		if spec.Since != "" && version != "" && !versionAtLeast(version, spec.Since) {
+			*issues = append(*issues, ConfigIssue{
+				Severity: IssueError,
+				Key:      key,
+				Message:  fmt.Sprintf("requiere %s %s (instalada %s)", s.Tool, spec.Since, version),
+				Requires: spec.Since,
+			})
+			continue
+		}
+		if !tomlTypeMatches(spec.Type, value) {
+			*issues = append(*issues, ConfigIssue{
+				Severity: IssueError,
+				Key:      key,
+				Message:  fmt.Sprintf("se esperaba %s y es %s", strings.ReplaceAll(spec.Type, "|", " o "), tomlTypeName(value)),
+			})
+			continue
+		}
+		if sub, isTable := value.(map[string]interface{}); isTable && !spec.Open {
+			s.check(sub, key, version, issues)
+		}
+	}
+}
+
+// hasChildren indica si el esquema conoce opciones dentro de la tabla key
+func (s *toolSchema) hasChildren(key string) bool {
+	for known := range s.Keys {
+		if strings.HasPrefix(known, key+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// tomlTypeMatches comprueba el valor contra un tipo del esquema
+func tomlTypeMatches(types string, value interface{}) bool {
+	for _, t := range strings.Split(types, "|") {
+		if t == "any" || t == tomlTypeName(value) || (t == "float" && tomlTypeName(value) == "int") {
+			return true
+		}
+	}
+	return false
+}
+
+// tomlTypeName nombre del tipo de un valor leído con ParseTOML
+func tomlTypeName(value interface{}) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "bool"
+	case int64:
+		return "int"
+	case float64:
+		return "float"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "table"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// validateBeforeWrite valida el contenido generado y se niega a escribirlo si no es válido
+func validateBeforeWrite(path, content string) error {
+	var errs []string
+	for _, issue := range ValidateConfig(path, content) {
+		if issue.Severity == IssueWarning {
+			fmt.Printf("⚠ %s: %s\n", filepath.Base(path), issue)
+			continue
+		}
+		errs = append(errs, "  ✗ "+issue.String())
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	if ForceConfigWrite {
+		fmt.Printf("⚠ %s no es válido; se escribe igualmente (--force):\n%s\n", path, strings.Join(errs, "\n"))
+		return nil
+	}
+	return fmt.Errorf("configuración no válida, no se escribe %s (usa --force para escribirla igualmente):\n%s", path, strings.Join(errs, "\n"))
+}
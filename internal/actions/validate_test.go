@@ -0,0 +1,113 @@
+package actions
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestValidateAlacrittyVersion(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	path := GetAlacrittyConfigPath()
+	config := "[terminal.shell]\nprogram = \"/usr/bin/nu\"\n\n[font]\nsize = 13\n"
+
+	tests := []struct {
+		version  string
+		requires string // Vacío: sin errores
+	}{
+		{"0.12.3", "0.13"},
+		{"0.13.2", "0.14"},
+		{"0.14.0", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			toolVersions["alacritty"] = tt.version
+			t.Cleanup(func() { delete(toolVersions, "alacritty") })
+
+			issues := ValidateConfig(path, config)
+			if tt.requires == "" {
+				if len(issues) > 0 {
+					t.Errorf("ValidateConfig() = %v, want sin problemas", issues)
+				}
+				return
+			}
+			if len(issues) == 0 || issues[0].Severity != IssueError || issues[0].Requires != tt.requires {
+				t.Errorf("ValidateConfig() = %+v, want error que requiere %s", issues, tt.requires)
+			}
+		})
+	}
+}
+
+func TestValidateStarship(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	path := GetStarshipConfigPath()
+
+	// Lo que genera xebec pasa el esquema con cualquier diseño
+	tmpl, err := os.ReadFile("../../starship/starship.toml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, layout := range []string{StarshipLayoutPowerline, StarshipLayoutMinimal} {
+		out, err := RenderStarshipConfig(string(tmpl), []PaletteColor{{Name: "primary", Hex: "#66D9EF"}}, StarshipConfigOptions{Git: true, Time: true, Layout: layout})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if issues := ValidateConfig(path, out); len(issues) > 0 {
+			t.Errorf("%s: ValidateConfig() = %v", layout, issues)
+		}
+	}
+
+	tests := []struct {
+		config, key string
+	}{
+		{"add_newline = 'yes'\n", "add_newline"},
+		{"command_timeout = 1.5\n", "command_timeout"},
+		{"add_newlin = true\n", "add_newlin"},
+		{"format = 3\n\n[git_branch]\nsymbol = ' '\n\n[palettes.xebec]\nprimary = '#fff'\n", "format"},
+	}
+	for _, tt := range tests {
+		issues := ValidateConfig(path, tt.config)
+		if len(issues) != 1 || issues[0].Key != tt.key || issues[0].Severity != IssueError {
+			t.Errorf("ValidateConfig(%q) = %v, want un error en %s", tt.config, issues, tt.key)
+		}
+	}
+}
+
+func TestValidateKittyGhostty(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	tests := []struct {
+		name, path, golden string
+		broken             []string // Cada línea rompe el archivo
+	}{
+		{
+			name: "kitty", path: GetKittyXebecPath(), golden: "kitty-all.conf",
+			broken: []string{"font_size", "font-size 13", "background #12345", "= yes"},
+		},
+		{
+			name: "ghostty", path: GetGhosttyXebecPath(), golden: "ghostty-all",
+			broken: []string{"font-size 13", "font_size = 13", "palette = 256=#FFFFFF", "palette = 1=red", "background = #GGGGGG"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Lo que genera xebec es válido
+			data, err := os.ReadFile("testdata/golden/" + tt.golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if issues := ValidateConfig(tt.path, string(data)); len(issues) > 0 {
+				t.Errorf("%s: ValidateConfig() = %v", tt.golden, issues)
+			}
+			for _, line := range tt.broken {
+				issues := ValidateConfig(tt.path, "# xebec\n\n"+line+"\n")
+				if len(issues) != 1 || issues[0].Severity != IssueError || !strings.Contains(issues[0].Message, "línea 3") {
+					t.Errorf("ValidateConfig(%q) = %v, want error en la línea 3", line, issues)
+				}
+			}
+		})
+	}
+}
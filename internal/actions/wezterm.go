@@ -0,0 +1,232 @@
+// Package: actions
+// Acciones de configuración de WezTerm
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// Opciones de configuración de WezTerm (mismos grupos que Alacritty + tab bar)
+type WezTermConfigOptions struct {
+	Window bool // window_decorations, opacity, padding, maximizado
+	Colors bool // foreground, background, ansi, brights
+	Font   bool // font, font_size
+	Cursor bool // default_cursor_style, cursor_blink_rate
+	Shell  bool // default_prog
+	TabBar bool // tab bar retro con colores XEBEC
+}
+
+// GetWezTermConfigOptions retorna las opciones disponibles para configurar
+func GetWezTermConfigOptions() []ConfigOption {
+	return []ConfigOption{
+		{ID: "window", Title: "Ventana", Description: "decorations, opacity, padding, maximizado", Key: "window"},
+		{ID: "colors", Title: "Colores", Description: "Tema XEBEC - ansi, brights, cursor, selección", Key: "colors"},
+		{ID: "font", Title: "Fuente", Description: "JetBrains Mono, tamaño", Key: "font"},
+		{ID: "cursor", Title: "Cursor", Description: "Barra parpadeante", Key: "cursor"},
+		{ID: "shell", Title: "Shell", Description: "default_prog con Nushell", Key: "shell"},
+		{ID: "tab_bar", Title: "Pestañas", Description: "Tab bar abajo, oculta con una sola pestaña", Key: "tab_bar"},
+	}
+}
+
+// WezTermOptionsFromIDs construye las opciones a partir de los IDs marcados
+func WezTermOptionsFromIDs(ids []string) WezTermConfigOptions {
+	return WezTermConfigOptions{
+		Window: containsID(ids, "window"),
+		Colors: containsID(ids, "colors"),
+		Font:   containsID(ids, "font"),
+		Cursor: containsID(ids, "cursor"),
+		Shell:  containsID(ids, "shell"),
+		TabBar: containsID(ids, "tab_bar"),
+	}
+}
+
+// IsEmpty indica si no se seleccionó ninguna opción
+func (o WezTermConfigOptions) IsEmpty() bool {
+	return !o.Window && !o.Colors && !o.Font && !o.Cursor && !o.Shell && !o.TabBar
+}
+
+// modules retorna el mapa de módulos usado por la plantilla
+func (o WezTermConfigOptions) modules() map[string]bool {
+	return map[string]bool{
+		"window":  o.Window,
+		"colors":  o.Colors,
+		"font":    o.Font,
+		"cursor":  o.Cursor,
+		"shell":   o.Shell,
+		"tab_bar": o.TabBar,
+	}
+}
+
+// WezTermTemplateData datos para renderizar xebec.lua
+type WezTermTemplateData struct {
+	Modules    map[string]bool
+	ModulePath string
+	Palette    TerminalPalette
+	Program    string // Ejecutable como string Lua; vacío si no hay shell
+	Args       []string
+}
+
+// GetWezTermConfigPath retorna el archivo de configuración que WezTerm carga
+// Orden de WezTerm: $WEZTERM_CONFIG_FILE, ~/.config/wezterm/wezterm.lua, ~/.wezterm.lua
+func GetWezTermConfigPath() string {
+	if p := os.Getenv("WEZTERM_CONFIG_FILE"); p != "" {
+		return p
+	}
+	xdgPath := filepath.Join(xdgConfigHome(), "wezterm", "wezterm.lua")
+	if _, err := os.Stat(xdgPath); err == nil {
+		return xdgPath
+	}
+	homePath := filepath.Join(userHome(), ".wezterm.lua")
+	if _, err := os.Stat(homePath); err == nil {
+		return homePath
+	}
+	return xdgPath
+}
+
+// GetWezTermModulePath retorna la ruta del módulo xebec.lua
+func GetWezTermModulePath() string {
+	return filepath.Join(xdgConfigHome(), "wezterm", "xebec.lua")
+}
+
+// GetWezTermSourceDir retorna el directorio con las plantillas base de WezTerm
+func GetWezTermSourceDir() string {
+	_, currentFile, _, _ := runtime.Caller(0)
+	projectRoot := filepath.Dir(filepath.Dir(filepath.Dir(currentFile)))
+	return filepath.Join(projectRoot, "wezterm")
+}
+
+// luaString escribe un string Lua entre comillas simples
+func luaString(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	return "'" + strings.ReplaceAll(value, "'", `\'`) + "'"
+}
+
+// NewWezTermTemplateData construye los datos de plantilla para la máquina actual
+func NewWezTermTemplateData(opts WezTermConfigOptions) WezTermTemplateData {
+	data := WezTermTemplateData{
+		Modules:    opts.modules(),
+		ModulePath: GetWezTermModulePath(),
+		Palette:    XebecPalette(),
+	}
+	// Nushell con --login, igual que la plantilla de Alacritty
+	if shell, err := FindShell("nu"); err == nil {
+		if program, err := ResolveShellProgram(shell); err == nil {
+			data.Program = luaString(program)
+			data.Args = []string{"--login"}
+		}
+	}
+	return data
+}
+
+// RenderWezTermConfig genera xebec.lua a partir de la plantilla
+func RenderWezTermConfig(tmpl string, data WezTermTemplateData) (string, error) {
+	return renderTemplate("xebec.lua", tmpl, data)
+}
+
+// wezTermRequireBlock carga el módulo desde wezterm.lua
+func wezTermRequireBlock(modulePath string) string {
+	return fmt.Sprintf("dofile(%s).apply_to_config(config)", luaString(modulePath))
+}
+
+// ConfigureWezTerm genera xebec.lua y lo aplica desde wezterm.lua
+func ConfigureWezTerm(opts WezTermConfigOptions) (err error) {
+	defer recordConfigured("wezterm", opts.modules(), &err)
+
+	// Verificar que WezTerm esté instalado
+	if !IsWezTermInstalled() {
+		return fmt.Errorf("WezTerm no está instalado en el sistema")
+	}
+
+	sourceDir := GetWezTermSourceDir()
+	tmpl, err := os.ReadFile(filepath.Join(sourceDir, "xebec.lua"))
+	if err != nil {
+		return fmt.Errorf("error leyendo plantilla xebec.lua: %w", err)
+	}
+
+	data := NewWezTermTemplateData(opts)
+	if opts.Shell && data.Program == "" {
+		fmt.Println("⚠ Nushell no está instalado; default_prog no se modifica")
+	}
+	content, err := RenderWezTermConfig(string(tmpl), data)
+	if err != nil {
+		return fmt.Errorf("error generando configuración: %w", err)
+	}
+	if err := writeFragment(data.ModulePath, content); err != nil {
+		return err
+	}
+
+	// Crear wezterm.lua si el usuario no tiene uno
+	configPath := GetWezTermConfigPath()
+	if _, err := os.Stat(configPath); os.IsNotExist(err) {
+		base, err := os.ReadFile(filepath.Join(sourceDir, "wezterm.lua"))
+		if err != nil {
+			return fmt.Errorf("error leyendo plantilla wezterm.lua: %w", err)
+		}
+		if err := writeFragment(configPath, string(base)); err != nil {
+			return err
+		}
+	}
+
+	return applyWezTermBlock(configPath, "xebec", wezTermRequireBlock(data.ModulePath))
+}
+
+// applyWezTermBlock inyecta un bloque antes de `return config`
+func applyWezTermBlock(path, component, body string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error leyendo %s: %w", path, err)
+	}
+	if !strings.Contains(string(data), "return config") {
+		return fmt.Errorf("%s no termina en `return config`; añade a mano antes del return: %s", path, body)
+	}
+
+	result, err := UpsertBlockInFileBefore(path, component, body, "return config")
+	if err != nil {
+		return err
+	}
+	if result.Drifted {
+		fmt.Printf("⚠ El bloque xebec:%s de %s tenía cambios manuales (backup: %s)\n", component, result.Path, result.BackupPath)
+	}
+	if result.Changed {
+		fmt.Printf("✓ Bloque xebec:%s actualizado en %s\n", component, result.Path)
+	}
+	return nil
+}
+
+// SetWezTermDefaultProg fija config.default_prog en un bloque gestionado antes de `return config`
+func SetWezTermDefaultProg(path, program string) error {
+	return applyWezTermBlock(path, "default_prog", fmt.Sprintf("config.default_prog = { %s }", luaString(program)))
+}
+
+// IsWezTermInstalled verifica si WezTerm está instalado
+func IsWezTermInstalled() bool {
+	if isAnyBinaryInstalled([]string{"wezterm"}) {
+		return true
+	}
+	if runtime.GOOS == "windows" {
+		p := filepath.Join(os.Getenv("ProgramFiles"), "WezTerm", "wezterm.exe")
+		if _, err := os.Stat(p); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// GetWezTermStatus retorna el estado actual de WezTerm
+func GetWezTermStatus() (installed bool, configured bool, configPath string) {
+	configPath = GetWezTermModulePath()
+	installed = IsWezTermInstalled()
+
+	if _, err := os.Stat(configPath); err == nil {
+		configured = true
+	}
+
+	return
+}
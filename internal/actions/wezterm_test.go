@@ -0,0 +1,101 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRenderWezTermConfigGolden(t *testing.T) {
+	goldenHome(t)
+	t.Setenv("WEZTERM_CONFIG_FILE", "")
+	tmpl, err := os.ReadFile(filepath.Join(GetWezTermSourceDir(), "xebec.lua"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		ids  []string
+	}{
+		{name: "all", ids: Configurator{Options: GetWezTermConfigOptions}.DefaultSections()},
+		{name: "colors", ids: []string{"colors"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := NewWezTermTemplateData(WezTermOptionsFromIDs(tt.ids))
+			// default_prog fijo: el de NewWezTermTemplateData depende del nu instalado
+			data.Program = luaString("/usr/bin/nu")
+			data.Args = []string{"--login"}
+			out, err := RenderWezTermConfig(string(tmpl), data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertGolden(t, "wezterm-"+tt.name+".lua", out)
+		})
+	}
+}
+
+func TestConfigureWezTermRequireBlock(t *testing.T) {
+	setupPlanHome(t)
+	t.Setenv("WEZTERM_CONFIG_FILE", "")
+	fakeBinaries(t, "wezterm")
+	configPath := filepath.Join(os.Getenv("XDG_CONFIG_HOME"), "wezterm", "wezterm.lua")
+	require := wezTermRequireBlock(GetWezTermModulePath())
+	opts := WezTermOptionsFromIDs([]string{"colors"})
+
+	configure := func() string {
+		t.Helper()
+		if err := ConfigureWezTerm(opts); err != nil {
+			t.Fatal(err)
+		}
+		data, err := os.ReadFile(configPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	assertWired := func(content string) {
+		t.Helper()
+		if n := strings.Count(content, require); n != 1 {
+			t.Fatalf("%d cargas de xebec.lua, want 1:\n%s", n, content)
+		}
+		if strings.Index(content, require) > strings.LastIndex(content, "return config") {
+			t.Errorf("la carga de xebec.lua va después de `return config`:\n%s", content)
+		}
+	}
+
+	// wezterm.lua del usuario: se añade la carga antes del return y se conserva lo suyo
+	user := "local wezterm = require 'wezterm'\nlocal config = wezterm.config_builder()\nconfig.font_size = 15\n\nreturn config\n"
+	writeTestFile(t, configPath, user)
+	first := configure()
+	assertWired(first)
+	if !strings.Contains(first, "config.font_size = 15\n") {
+		t.Errorf("se perdió la configuración del usuario:\n%s", first)
+	}
+	if _, err := os.Stat(GetWezTermModulePath()); err != nil {
+		t.Errorf("no se generó xebec.lua: %v", err)
+	}
+	if again := configure(); again != first {
+		t.Errorf("segunda pasada cambió wezterm.lua:\n%s", again)
+	}
+
+	// Sin wezterm.lua se crea desde la plantilla base
+	os.Remove(configPath)
+	created := configure()
+	assertWired(created)
+	if !strings.Contains(created, "-- Tus ajustes aquí") {
+		t.Errorf("wezterm.lua no parte de la plantilla base:\n%s", created)
+	}
+
+	// Sin `return config` no se sabe dónde insertar: error y archivo intacto
+	odd := "return { font_size = 15 }\n"
+	writeTestFile(t, configPath, odd)
+	if err := ConfigureWezTerm(opts); err == nil {
+		t.Error("ConfigureWezTerm sin `return config` debería fallar")
+	}
+	if data, _ := os.ReadFile(configPath); string(data) != odd {
+		t.Errorf("wezterm.lua modificado:\n%s", data)
+	}
+}
@@ -0,0 +1,460 @@
+// Package: actions
+// Acciones de configuración de Windows Terminal
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+
+	termos "github.com/XebecCorporation/XebecCorporation.Dots/internal/os"
+)
+
+// GetWindowsTerminalSettingsPath retorna settings.json de Windows Terminal
+// La detección de terminales (internal/os) y la configuración usan la misma ruta
+func GetWindowsTerminalSettingsPath() string {
+	return termos.WindowsTerminalSettingsPath()
+}
+
+// wtProfile campos de un perfil de Windows Terminal usados por XEBEC
+type wtProfile struct {
+	GUID        string `json:"guid"`
+	Name        string `json:"name"`
+	Commandline string `json:"commandline"`
+	Source      string `json:"source,omitempty"`
+}
+
+// wtSettings estructura mínima de settings.json
+type wtSettings struct {
+	DefaultProfile string `json:"defaultProfile"`
+	Profiles       struct {
+		List []wtProfile `json:"list"`
+	} `json:"profiles"`
+}
+
+// wtProfileSources perfiles dinámicos que Windows Terminal genera para cada shell
+var wtProfileSources = map[string]string{
+	"powershell": "Windows.Terminal.PowershellCore",
+}
+
+// findWTProfile busca el perfil que lanza el shell (por commandline o por generador dinámico)
+func findWTProfile(profiles []wtProfile, shellID, program string) (wtProfile, bool) {
+	exe := executableName(program)
+	for _, p := range profiles {
+		if p.Commandline != "" && executableName(p.Commandline) == exe {
+			return p, true
+		}
+	}
+	if source, ok := wtProfileSources[shellID]; ok {
+		for _, p := range profiles {
+			if p.Source == source {
+				return p, true
+			}
+		}
+	}
+	return wtProfile{}, false
+}
+
+// executableName retorna el nombre del ejecutable de una línea de comandos, sin .exe
+func executableName(commandline string) string {
+	commandline = strings.TrimSpace(commandline)
+	if strings.HasPrefix(commandline, `"`) {
+		if end := strings.Index(commandline[1:], `"`); end >= 0 {
+			commandline = commandline[1 : end+1]
+		}
+	} else if idx := strings.Index(strings.ToLower(commandline), ".exe"); idx >= 0 {
+		commandline = commandline[:idx+len(".exe")]
+	} else if fields := strings.Fields(commandline); len(fields) > 0 {
+		commandline = fields[0]
+	}
+	base := commandline[strings.LastIndexAny(commandline, `/\`)+1:]
+	return strings.TrimSuffix(strings.ToLower(base), ".exe")
+}
+
+// SetWindowsTerminalDefaultProfile marca como perfil por defecto el del shell dado
+// Si no existe un perfil para el shell lo crea. Edita el texto para conservar comentarios
+func SetWindowsTerminalDefaultProfile(content string, shellID, name, program string) (string, error) {
+	var settings wtSettings
+	if err := json.Unmarshal(StripJSONC([]byte(content)), &settings); err != nil {
+		return "", fmt.Errorf("settings.json inválido: %w", err)
+	}
+
+	profile, found := findWTProfile(settings.Profiles.List, shellID, program)
+	if !found {
+		profile = wtProfile{GUID: newGUID(), Name: name, Commandline: program}
+		open, err := wtProfileList(content)
+		if err != nil {
+			return "", err
+		}
+		if content, err = upsertJSONCArrayObject(content, open, "guid", profile.GUID, profile); err != nil {
+			return "", err
+		}
+	}
+
+	return setWTDefaultProfile(content, profile.GUID)
+}
+
+// wtProfileList retorna el índice del '[' de profiles.list
+func wtProfileList(content string) (int, error) {
+	root := jsoncNext(content, 0)
+	if root < 0 || content[root] != '{' {
+		return 0, fmt.Errorf("settings.json inválido")
+	}
+	open, ok := jsoncFindKey(content, root, "profiles")
+	if ok && content[open] == '{' {
+		open, ok = jsoncFindKey(content, open, "list")
+	}
+	if !ok || content[open] != '[' {
+		return 0, fmt.Errorf("settings.json no tiene profiles.list")
+	}
+	return open, nil
+}
+
+// setWTDefaultProfile fija defaultProfile en la raíz, añadiéndolo si no existe
+func setWTDefaultProfile(content, guid string) (string, error) {
+	value, _ := json.Marshal(guid)
+	root := jsoncNext(content, 0)
+	if root < 0 || content[root] != '{' {
+		return "", fmt.Errorf("settings.json inválido")
+	}
+	if start, ok := jsoncFindKey(content, root, "defaultProfile"); ok {
+		if content[start] != '"' {
+			return "", fmt.Errorf("settings.json: defaultProfile no es una cadena")
+		}
+		return content[:start] + string(value) + content[jsoncSkip(content, start)+1:], nil
+	}
+	return content[:root+1] + eolOf(content) + "    \"defaultProfile\": " + string(value) + "," + content[root+1:], nil
+}
+
+// XebecWTProfileGUID GUID fijo del perfil XEBEC, para actualizarlo en lugar de duplicarlo
+const XebecWTProfileGUID = "{5e8ec0de-7a6b-4c1d-9e2f-00aeef000001}"
+
+// XebecWTSchemeName nombre fijo del esquema XEBEC: al cambiar de tema se actualiza en lugar de añadir otro
+const XebecWTSchemeName = "XEBEC"
+
+// Opciones de configuración de Windows Terminal (mismos grupos que Alacritty)
+type WindowsTerminalConfigOptions struct {
+	Window  bool // opacity, useAcrylic, padding
+	Colors  bool // esquema de colores XEBEC
+	Font    bool // font.face, font.size
+	Cursor  bool // cursorShape
+	Shell   bool // commandline con nu.exe
+	Default bool // defaultProfile = perfil XEBEC
+}
+
+// GetWindowsTerminalConfigOptions retorna las opciones disponibles para configurar
+func GetWindowsTerminalConfigOptions() []ConfigOption {
+	return []ConfigOption{
+		{ID: "window", Title: "Ventana", Description: "Opacidad 85 con acrílico, sin padding", Key: "window"},
+		{ID: "colors", Title: "Colores", Description: "Esquema XEBEC en schemes", Key: "colors"},
+		{ID: "font", Title: "Fuente", Description: "JetBrains Mono, tamaño", Key: "font"},
+		{ID: "cursor", Title: "Cursor", Description: "Barra", Key: "cursor"},
+		{ID: "shell", Title: "Shell", Description: "commandline con nu.exe", Key: "shell"},
+		{ID: "default", Title: "Perfil por defecto", Description: "Abrir el perfil XEBEC al iniciar", Key: "default"},
+	}
+}
+
+// WindowsTerminalOptionsFromIDs construye las opciones a partir de los IDs marcados
+func WindowsTerminalOptionsFromIDs(ids []string) WindowsTerminalConfigOptions {
+	return WindowsTerminalConfigOptions{
+		Window:  containsID(ids, "window"),
+		Colors:  containsID(ids, "colors"),
+		Font:    containsID(ids, "font"),
+		Cursor:  containsID(ids, "cursor"),
+		Shell:   containsID(ids, "shell"),
+		Default: containsID(ids, "default"),
+	}
+}
+
+// IsEmpty indica si no se seleccionó ninguna opción
+func (o WindowsTerminalConfigOptions) IsEmpty() bool {
+	return !o.Window && !o.Colors && !o.Font && !o.Cursor && !o.Shell && !o.Default
+}
+
+// modules retorna las opciones marcadas por ID
+func (o WindowsTerminalConfigOptions) modules() map[string]bool {
+	return map[string]bool{
+		"window":  o.Window,
+		"colors":  o.Colors,
+		"font":    o.Font,
+		"cursor":  o.Cursor,
+		"shell":   o.Shell,
+		"default": o.Default,
+	}
+}
+
+// wtScheme esquema de colores de Windows Terminal
+type wtScheme struct {
+	Name                string `json:"name"`
+	Background          string `json:"background"`
+	Foreground          string `json:"foreground"`
+	CursorColor         string `json:"cursorColor"`
+	SelectionBackground string `json:"selectionBackground"`
+	Black               string `json:"black"`
+	Red                 string `json:"red"`
+	Green               string `json:"green"`
+	Yellow              string `json:"yellow"`
+	Blue                string `json:"blue"`
+	Purple              string `json:"purple"`
+	Cyan                string `json:"cyan"`
+	White               string `json:"white"`
+	BrightBlack         string `json:"brightBlack"`
+	BrightRed           string `json:"brightRed"`
+	BrightGreen         string `json:"brightGreen"`
+	BrightYellow        string `json:"brightYellow"`
+	BrightBlue          string `json:"brightBlue"`
+	BrightPurple        string `json:"brightPurple"`
+	BrightCyan          string `json:"brightCyan"`
+	BrightWhite         string `json:"brightWhite"`
+}
+
+// newWTScheme convierte una paleta de terminal al formato de Windows Terminal
+func newWTScheme(p TerminalPalette) wtScheme {
+	return wtScheme{
+		Name:                p.Name,
+		Background:          p.Background,
+		Foreground:          p.Foreground,
+		CursorColor:         p.Cursor,
+		SelectionBackground: p.Selection,
+		Black:               p.Normal.Black,
+		Red:                 p.Normal.Red,
+		Green:               p.Normal.Green,
+		Yellow:              p.Normal.Yellow,
+		Blue:                p.Normal.Blue,
+		Purple:              p.Normal.Magenta,
+		Cyan:                p.Normal.Cyan,
+		White:               p.Normal.White,
+		BrightBlack:         p.Bright.Black,
+		BrightRed:           p.Bright.Red,
+		BrightGreen:         p.Bright.Green,
+		BrightYellow:        p.Bright.Yellow,
+		BrightBlue:          p.Bright.Blue,
+		BrightPurple:        p.Bright.Magenta,
+		BrightCyan:          p.Bright.Cyan,
+		BrightWhite:         p.Bright.White,
+	}
+}
+
+// wtFont fuente de un perfil
+type wtFont struct {
+	Face string  `json:"face"`
+	Size float64 `json:"size"`
+}
+
+// wtXebecProfile perfil XEBEC; los grupos no marcados se omiten
+type wtXebecProfile struct {
+	GUID        string  `json:"guid"`
+	Name        string  `json:"name"`
+	Commandline string  `json:"commandline,omitempty"`
+	ColorScheme string  `json:"colorScheme,omitempty"`
+	Font        *wtFont `json:"font,omitempty"`
+	Opacity     int     `json:"opacity,omitempty"`
+	UseAcrylic  bool    `json:"useAcrylic,omitempty"`
+	Padding     string  `json:"padding,omitempty"`
+	CursorShape string  `json:"cursorShape,omitempty"`
+}
+
+// newWTXebecProfile construye el perfil XEBEC; program es la ruta de nu.exe (vacía si no hay)
+func newWTXebecProfile(opts WindowsTerminalConfigOptions, program string) wtXebecProfile {
+	profile := wtXebecProfile{GUID: XebecWTProfileGUID, Name: XebecPalette().Name}
+	if opts.Shell && program != "" {
+		profile.Commandline = program
+		if strings.ContainsAny(program, " \t") {
+			profile.Commandline = `"` + program + `"`
+		}
+	}
+	if opts.Colors {
+		profile.ColorScheme = XebecWTSchemeName
+	}
+	if opts.Font {
+		profile.Font = &wtFont{Face: "JetBrains Mono", Size: 13}
+	}
+	if opts.Window {
+		profile.Opacity = 85
+		profile.UseAcrylic = true
+		profile.Padding = "0"
+	}
+	if opts.Cursor {
+		profile.CursorShape = "bar"
+	}
+	return profile
+}
+
+// ApplyWindowsTerminalConfig añade o actualiza el esquema y el perfil XEBEC en settings.json
+// Trabaja sobre el texto para conservar comentarios y claves desconocidas; no toca disco,
+// así se puede probar con cualquier settings.json de ejemplo
+func ApplyWindowsTerminalConfig(content string, opts WindowsTerminalConfigOptions, program string) (string, error) {
+	var settings map[string]interface{}
+	if err := json.Unmarshal(StripJSONC([]byte(content)), &settings); err != nil {
+		return "", fmt.Errorf("settings.json inválido: %w", err)
+	}
+
+	var err error
+	if opts.Colors {
+		scheme := newWTScheme(XebecPalette())
+		scheme.Name = XebecWTSchemeName
+		content, err = upsertWTScheme(content, scheme)
+		if err != nil {
+			return "", err
+		}
+	}
+	content, err = upsertWTProfile(content, newWTXebecProfile(opts, program))
+	if err != nil {
+		return "", err
+	}
+	if opts.Default {
+		return setWTDefaultProfile(content, XebecWTProfileGUID)
+	}
+	return content, nil
+}
+
+// upsertWTScheme reemplaza el esquema con el mismo nombre o lo añade a schemes
+func upsertWTScheme(content string, scheme wtScheme) (string, error) {
+	root := jsoncNext(content, 0)
+	if root < 0 || content[root] != '{' {
+		return "", fmt.Errorf("settings.json inválido")
+	}
+	open, ok := jsoncFindKey(content, root, "schemes")
+	if !ok {
+		content = content[:root+1] + eolOf(content) + "    \"schemes\": []," + content[root+1:]
+		open, _ = jsoncFindKey(content, root, "schemes")
+	}
+	if content[open] != '[' {
+		return "", fmt.Errorf("settings.json: schemes no es una lista")
+	}
+	return upsertJSONCArrayObject(content, open, "name", scheme.Name, scheme)
+}
+
+// upsertWTProfile reemplaza el perfil con el mismo guid o lo añade a profiles.list
+func upsertWTProfile(content string, profile wtXebecProfile) (string, error) {
+	open, err := wtProfileList(content)
+	if err != nil {
+		return "", err
+	}
+	return upsertJSONCArrayObject(content, open, "guid", profile.GUID, profile)
+}
+
+// upsertJSONCArrayObject actualiza el objeto del array cuyo campo key vale value,
+// o lo inserta al principio del array con la indentación del archivo
+// En un objeto existente solo se fijan las claves de obj: las demás claves y los comentarios se conservan
+func upsertJSONCArrayObject(content string, open int, key, value string, obj interface{}) (string, error) {
+	spans, close, err := jsoncArrayObjects(content, open)
+	if err != nil {
+		return "", fmt.Errorf("settings.json inválido: %w", err)
+	}
+
+	for _, span := range spans {
+		var fields map[string]interface{}
+		if err := json.Unmarshal(StripJSONC([]byte(content[span[0]:span[1]+1])), &fields); err != nil {
+			continue
+		}
+		if current, _ := fields[key].(string); !strings.EqualFold(current, value) {
+			continue
+		}
+		members, err := jsonMembers(obj)
+		if err != nil {
+			return "", err
+		}
+		// Al revés: cada clave nueva se inserta al principio del objeto
+		for i := len(members) - 1; i >= 0; i-- {
+			content = jsoncSetKey(content, span[0], members[i].Key, members[i].Value)
+		}
+		return content, nil
+	}
+
+	indent := lineIndent(content, open) + "    "
+	data, err := json.MarshalIndent(obj, indent, "    ")
+	if err != nil {
+		return "", err
+	}
+	text := strings.ReplaceAll(string(data), "\n", eolOf(content))
+
+	eol := eolOf(content)
+	if len(spans) == 0 {
+		return content[:open+1] + eol + indent + text + eol + lineIndent(content, open) + content[close:], nil
+	}
+	return content[:open+1] + eol + indent + text + "," + content[open+1:], nil
+}
+
+// eolOf retorna el fin de línea del archivo (CRLF en settings.json creados en Windows)
+func eolOf(content string) string {
+	if strings.Contains(content, "\r\n") {
+		return "\r\n"
+	}
+	return "\n"
+}
+
+// ConfigureWindowsTerminal añade el esquema y el perfil XEBEC a settings.json
+func ConfigureWindowsTerminal(opts WindowsTerminalConfigOptions) (err error) {
+	defer recordConfigured("windows_terminal", opts.modules(), &err)
+
+	path := GetWindowsTerminalSettingsPath()
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return fmt.Errorf("no existe %s; abre Windows Terminal una vez para crearlo", path)
+	}
+	if err != nil {
+		return fmt.Errorf("error leyendo %s: %w", path, err)
+	}
+
+	var program string
+	if opts.Shell {
+		if shell, err := FindShell("nu"); err == nil {
+			program, _ = ResolveShellProgram(shell)
+		}
+		if program == "" {
+			fmt.Println("⚠ Nushell no está instalado; el perfil usará el shell por defecto")
+		}
+	}
+
+	updated, err := ApplyWindowsTerminalConfig(string(data), opts, program)
+	if err != nil {
+		return err
+	}
+	if updated == string(data) {
+		trackWrite(path, updated)
+		fmt.Printf("• %s ya está actualizado\n", path)
+		return nil
+	}
+
+	var result BlockResult
+	if err := writeWithBackup(path, updated, &result); err != nil {
+		return err
+	}
+	trackWrite(path, updated)
+	if result.BackupPath != "" {
+		fmt.Printf("✓ Backup creado: %s\n", result.BackupPath)
+	}
+	fmt.Printf("✓ Perfil XEBEC aplicado: %s\n", path)
+	return nil
+}
+
+// IsWindowsTerminalInstalled verifica si Windows Terminal está instalado
+func IsWindowsTerminalInstalled() bool {
+	if runtime.GOOS != "windows" {
+		return false
+	}
+	if isAnyBinaryInstalled([]string{"wt"}) {
+		return true
+	}
+	_, err := os.Stat(GetWindowsTerminalSettingsPath())
+	return err == nil
+}
+
+// GetWindowsTerminalStatus retorna el estado actual de Windows Terminal
+// configured indica si settings.json ya tiene el perfil XEBEC
+func GetWindowsTerminalStatus() (installed bool, configured bool, configPath string) {
+	configPath = GetWindowsTerminalSettingsPath()
+	installed = IsWindowsTerminalInstalled()
+
+	if data, err := os.ReadFile(configPath); err == nil {
+		configured = strings.Contains(strings.ToLower(string(data)), strings.ToLower(XebecWTProfileGUID))
+	}
+
+	return
+}
@@ -0,0 +1,199 @@
+package actions
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// readWTFixture lee un settings.json de testdata/windowsterminal
+func readWTFixture(t *testing.T, name string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "windowsterminal", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+// parseWTSettings lee el resultado como lo haría Windows Terminal
+func parseWTSettings(t *testing.T, content string) map[string]interface{} {
+	t.Helper()
+	var settings map[string]interface{}
+	if err := json.Unmarshal(StripJSONC([]byte(content)), &settings); err != nil {
+		t.Fatalf("resultado inválido: %v\n%s", err, content)
+	}
+	return settings
+}
+
+// wtObjects retorna los objetos de una lista cuyo campo key vale value
+func wtObjects(list interface{}, key, value string) []map[string]interface{} {
+	var found []map[string]interface{}
+	items, _ := list.([]interface{})
+	for _, item := range items {
+		obj, _ := item.(map[string]interface{})
+		if v, _ := obj[key].(string); strings.EqualFold(v, value) {
+			found = append(found, obj)
+		}
+	}
+	return found
+}
+
+func TestApplyWindowsTerminalConfig(t *testing.T) {
+	opts := WindowsTerminalConfigOptions{Window: true, Colors: true, Font: true, Cursor: true, Shell: true, Default: true}
+	program := `C:\Program Files\nu\bin\nu.exe`
+
+	tests := []struct {
+		fixture  string
+		profiles int      // Perfiles en profiles.list tras aplicar
+		keep     []string // Texto del usuario que debe conservarse
+	}{
+		{
+			fixture:  "commented.json",
+			profiles: 3,
+			keep:     []string{"// This file was initially generated", "/* Lista antigua", "// pegar", `"launch": { "list": [] }`},
+		},
+		{
+			fixture:  "existing_xebec.json",
+			profiles: 2,
+			keep:     []string{"// XEBEC de una versión anterior", `"name": "Campbell"`},
+		},
+		{
+			fixture:  "custom_xebec.json",
+			profiles: 1,
+			keep: []string{
+				"// Ajustes propios sobre el perfil XEBEC",
+				`"startingDirectory": "%USERPROFILE%\\src"`,
+				`"bellStyle": "none", // sin pitidos`,
+				`"weight": "semi-bold"`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			content := readWTFixture(t, tt.fixture)
+			out, err := ApplyWindowsTerminalConfig(content, opts, program)
+			if err != nil {
+				t.Fatal(err)
+			}
+			settings := parseWTSettings(t, out)
+
+			if settings["defaultProfile"] != XebecWTProfileGUID {
+				t.Errorf("defaultProfile = %v", settings["defaultProfile"])
+			}
+			profiles, _ := settings["profiles"].(map[string]interface{})
+			list, _ := profiles["list"].([]interface{})
+			if len(list) != tt.profiles {
+				t.Errorf("got %d perfiles, want %d", len(list), tt.profiles)
+			}
+			xebec := wtObjects(list, "guid", XebecWTProfileGUID)
+			if len(xebec) != 1 {
+				t.Fatalf("got %d perfiles XEBEC, want 1", len(xebec))
+			}
+			if xebec[0]["commandline"] != `"`+program+`"` || xebec[0]["colorScheme"] != XebecWTSchemeName {
+				t.Errorf("perfil XEBEC: %v", xebec[0])
+			}
+			if font, _ := xebec[0]["font"].(map[string]interface{}); xebec[0]["opacity"] != float64(85) || font["face"] != "JetBrains Mono" {
+				t.Errorf("perfil XEBEC sin las claves de XEBEC: %v", xebec[0])
+			}
+			if schemes := wtObjects(settings["schemes"], "name", XebecWTSchemeName); len(schemes) != 1 || schemes[0]["background"] != XebecPalette().Background {
+				t.Errorf("esquema XEBEC: %v", schemes)
+			}
+			launch, _ := settings["launch"].(map[string]interface{})
+			if l, ok := launch["list"].([]interface{}); ok && len(l) != 0 {
+				t.Error("se insertó el perfil en una lista que no es profiles.list")
+			}
+			for _, keep := range tt.keep {
+				if !strings.Contains(out, keep) {
+					t.Errorf("se perdió %q", keep)
+				}
+			}
+
+			// Una segunda pasada no cambia nada
+			again, err := ApplyWindowsTerminalConfig(out, opts, program)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if again != out {
+				t.Errorf("la segunda pasada cambió el archivo:\n%s", again)
+			}
+		})
+	}
+}
+
+func TestSetWindowsTerminalDefaultProfile(t *testing.T) {
+	content := readWTFixture(t, "commented.json")
+
+	tests := []struct {
+		name     string
+		shellID  string
+		program  string
+		guid     string // Perfil existente esperado; vacío si se crea uno nuevo
+		profiles int
+	}{
+		{name: "perfil por commandline", shellID: "powershell", program: `C:\Windows\System32\WindowsPowerShell\v1.0\powershell.exe`, guid: "{61c54bbd-c2c6-5271-96e7-009a87ff44bf}", profiles: 2},
+		{name: "perfil dinámico", shellID: "powershell", program: `C:\Program Files\PowerShell\7\pwsh.exe`, guid: "{574e775e-4f2a-5b96-ac1e-a2962a402336}", profiles: 2},
+		{name: "perfil nuevo", shellID: "nu", program: `C:\Program Files\nu\bin\nu.exe`, profiles: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := SetWindowsTerminalDefaultProfile(content, tt.shellID, "Nushell", tt.program)
+			if err != nil {
+				t.Fatal(err)
+			}
+			settings := parseWTSettings(t, out)
+			profiles, _ := settings["profiles"].(map[string]interface{})
+			list, _ := profiles["list"].([]interface{})
+			if len(list) != tt.profiles {
+				t.Fatalf("got %d perfiles, want %d", len(list), tt.profiles)
+			}
+
+			guid := tt.guid
+			if guid == "" {
+				created := wtObjects(list, "commandline", tt.program)
+				if len(created) != 1 {
+					t.Fatalf("no se creó el perfil en profiles.list:\n%s", out)
+				}
+				guid, _ = created[0]["guid"].(string)
+			}
+			if settings["defaultProfile"] != guid {
+				t.Errorf("defaultProfile = %v, want %s", settings["defaultProfile"], guid)
+			}
+			// El defaultProfile comentado no se toca
+			if !strings.Contains(out, `// "defaultProfile": "{00000000-0000-0000-0000-000000000000}"`) {
+				t.Error("se modificó el defaultProfile comentado")
+			}
+		})
+	}
+}
+
+func TestApplyWindowsTerminalConfigThemeSwitch(t *testing.T) {
+	t.Cleanup(func() { SetActiveTheme(DefaultTheme()) })
+	opts := WindowsTerminalConfigOptions{Colors: true}
+	out, err := ApplyWindowsTerminalConfig(readWTFixture(t, "commented.json"), opts, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	light, err := ResolveTheme("xebec", AppearanceLight)
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetActiveTheme(light)
+	out, err = ApplyWindowsTerminalConfig(out, opts, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Un solo esquema XEBEC, ya con los colores del tema nuevo
+	schemes, _ := parseWTSettings(t, out)["schemes"].([]interface{})
+	xebec := wtObjects(schemes, "name", XebecWTSchemeName)
+	if len(xebec) != 1 || xebec[0]["background"] != light.TerminalPalette.Background {
+		t.Errorf("esquemas XEBEC tras cambiar de tema: %v", xebec)
+	}
+	if len(wtObjects(schemes, "name", light.TerminalPalette.Name)) != 0 {
+		t.Errorf("se añadió un esquema %q", light.TerminalPalette.Name)
+	}
+}
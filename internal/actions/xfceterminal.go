@@ -0,0 +1,81 @@
+// Package: actions
+// Acciones de configuración de xfce4-terminal (terminalrc y esquema XEBEC)
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// GetXfceTerminalConfigPath retorna la ruta de terminalrc
+func GetXfceTerminalConfigPath() string {
+	return filepath.Join(xdgConfigHome(), "xfce4", "terminal", "terminalrc")
+}
+
+// GetXfceTerminalSchemePath retorna la ruta del esquema de colores XEBEC
+func GetXfceTerminalSchemePath() string {
+	return filepath.Join(xdgDataHome(), "xfce4", "terminal", "colorschemes", "xebec.theme")
+}
+
+// GetXfceTerminalSourceDir retorna el directorio con las plantillas de xfce4-terminal
+func GetXfceTerminalSourceDir() string {
+	_, currentFile, _, _ := runtime.Caller(0)
+	projectRoot := filepath.Dir(filepath.Dir(filepath.Dir(currentFile)))
+	return filepath.Join(projectRoot, "xfce4-terminal")
+}
+
+// ConfigureXfceTerminal aplica las claves XEBEC a terminalrc y, con colores, instala el esquema
+func ConfigureXfceTerminal(opts TerminalProfileOptions) (err error) {
+	defer recordConfigured("xfce_terminal", opts.modules(), &err)
+
+	sourceDir := GetXfceTerminalSourceDir()
+	data := NewProfileTemplateData(opts)
+	if opts.Shell && data.Command == "" {
+		fmt.Println("⚠ Nushell no está instalado; se usará el shell de login")
+	}
+
+	if opts.Colors {
+		tmpl, err := os.ReadFile(filepath.Join(sourceDir, "xebec.theme"))
+		if err != nil {
+			return fmt.Errorf("error leyendo plantilla xebec.theme: %w", err)
+		}
+		scheme, err := renderTemplate("xebec.theme", string(tmpl), data)
+		if err != nil {
+			return fmt.Errorf("error generando configuración: %w", err)
+		}
+		if err := writeFragment(GetXfceTerminalSchemePath(), scheme); err != nil {
+			return err
+		}
+	}
+
+	tmpl, err := os.ReadFile(filepath.Join(sourceDir, "terminalrc"))
+	if err != nil {
+		return fmt.Errorf("error leyendo plantilla terminalrc: %w", err)
+	}
+	rendered, err := renderTemplate("terminalrc", string(tmpl), data)
+	if err != nil {
+		return fmt.Errorf("error generando configuración: %w", err)
+	}
+
+	return mergeINIFile(GetXfceTerminalConfigPath(), rendered)
+}
+
+// IsXfceTerminalInstalled verifica si xfce4-terminal está instalado
+func IsXfceTerminalInstalled() bool {
+	return isAnyBinaryInstalled([]string{"xfce4-terminal"})
+}
+
+// GetXfceTerminalStatus retorna el estado actual de xfce4-terminal
+// configured indica si terminalrc ya tiene la paleta XEBEC
+func GetXfceTerminalStatus() (installed bool, configured bool, configPath string) {
+	configPath = GetXfceTerminalConfigPath()
+	installed = IsXfceTerminalInstalled()
+
+	configured = iniHasValue(configPath, "Configuration", "ColorBackground", XebecPalette().Background)
+	return
+}
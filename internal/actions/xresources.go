@@ -0,0 +1,92 @@
+// Package: actions
+// Acciones de configuración de X resources (xterm, urxvt)
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// GetXresourcesConfigOptions retorna las opciones de X resources (xterm y urxvt usan $SHELL)
+func GetXresourcesConfigOptions() []ConfigOption {
+	var options []ConfigOption
+	for _, opt := range GetTerminalProfileOptions() {
+		if opt.ID != "shell" {
+			options = append(options, opt)
+		}
+	}
+	return options
+}
+
+// GetXresourcesPath retorna la ruta de ~/.Xresources
+func GetXresourcesPath() string {
+	return filepath.Join(userHome(), ".Xresources")
+}
+
+// GetXresourcesSourceDir retorna el directorio con la plantilla de X resources
+func GetXresourcesSourceDir() string {
+	_, currentFile, _, _ := runtime.Caller(0)
+	projectRoot := filepath.Dir(filepath.Dir(filepath.Dir(currentFile)))
+	return filepath.Join(projectRoot, "xresources")
+}
+
+// RenderXresources genera el cuerpo del bloque xebec:xresources
+func RenderXresources(tmpl string, data ProfileTemplateData) (string, error) {
+	body, err := renderTemplate("xebec.Xresources", tmpl, data)
+	return strings.TrimSpace(body), err
+}
+
+// ConfigureXresources inyecta el bloque XEBEC en ~/.Xresources y lo carga con xrdb
+func ConfigureXresources(opts TerminalProfileOptions) (err error) {
+	defer recordConfigured("xresources", opts.modules(), &err)
+
+	tmpl, err := os.ReadFile(filepath.Join(GetXresourcesSourceDir(), "xebec.Xresources"))
+	if err != nil {
+		return fmt.Errorf("error leyendo plantilla xebec.Xresources: %w", err)
+	}
+
+	opts.Shell = false
+	body, err := RenderXresources(string(tmpl), NewProfileTemplateData(opts))
+	if err != nil {
+		return fmt.Errorf("error generando configuración: %w", err)
+	}
+	path := GetXresourcesPath()
+	if err := upsertBlocks(path, []ManagedBlock{{Component: "xresources", Body: body}}); err != nil {
+		return err
+	}
+
+	// Sin servidor X el bloque queda en disco y se carga en la próxima sesión
+	if os.Getenv("DISPLAY") == "" || !isAnyBinaryInstalled([]string{"xrdb"}) {
+		fmt.Printf("• Carga los cambios con: xrdb -merge %s\n", path)
+		return nil
+	}
+	if out, err := exec.Command("xrdb", "-merge", path).CombinedOutput(); err != nil {
+		return fmt.Errorf("error ejecutando xrdb: %s", strings.TrimSpace(string(out)))
+	}
+	fmt.Println("✓ X resources recargados con xrdb")
+	return nil
+}
+
+// IsXresourcesTerminalInstalled verifica si hay algún terminal que lea X resources
+func IsXresourcesTerminalInstalled() bool {
+	return isAnyBinaryInstalled([]string{"xterm", "urxvt", "rxvt-unicode", "st"})
+}
+
+// GetXresourcesStatus retorna el estado actual de X resources
+func GetXresourcesStatus() (installed bool, configured bool, configPath string) {
+	configPath = GetXresourcesPath()
+	installed = IsXresourcesTerminalInstalled()
+
+	if status, err := CheckBlockInFile(configPath, "xresources"); err == nil {
+		configured = status != BlockMissing
+	}
+
+	return
+}
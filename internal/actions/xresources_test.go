@@ -0,0 +1,66 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestConfigureXresources(t *testing.T) {
+	setupPlanHome(t)
+	bin := fakeBinaries(t)
+	log := filepath.Join(bin, "xrdb.log")
+	fakeScript(t, bin, "xrdb", `echo "$@" >> '`+log+`'`+"\n")
+	t.Setenv("DISPLAY", ":0")
+
+	path := GetXresourcesPath()
+	user := "XTerm*faceName: Iosevka\n"
+	writeTestFile(t, path, user)
+
+	opts := TerminalProfileOptions{Colors: true, Font: true}
+	if err := ConfigureXresources(opts); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content := string(data)
+
+	// xrdb no admite comentarios con #: los marcadores usan !
+	if n := strings.Count(content, "! >>> xebec:xresources >>>"); n != 1 {
+		t.Errorf("%d marcadores ! de inicio, want 1:\n%s", n, content)
+	}
+	for _, line := range strings.Split(content, "\n") {
+		if strings.HasPrefix(line, "#") && !strings.HasPrefix(line, "#include") {
+			t.Errorf("línea con # fuera de un #include: %q", line)
+		}
+	}
+	if !strings.HasPrefix(content, user) {
+		t.Errorf("se perdió la configuración del usuario:\n%s", content)
+	}
+	if !strings.Contains(content, "*.background: "+DefaultTheme().Background) {
+		t.Errorf("falta el fondo del tema:\n%s", content)
+	}
+
+	if err := ConfigureXresources(opts); err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := os.ReadFile(path); string(again) != content {
+		t.Errorf("segunda pasada cambió .Xresources:\n%s", again)
+	}
+	if calls, _ := os.ReadFile(log); string(calls) != strings.Repeat("-merge "+path+"\n", 2) {
+		t.Errorf("llamadas a xrdb:\n%s", calls)
+	}
+
+	// Sin servidor X no se llama a xrdb
+	t.Setenv("DISPLAY", "")
+	os.Remove(log)
+	if err := ConfigureXresources(opts); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(log); !os.IsNotExist(err) {
+		t.Error("xrdb ejecutado sin DISPLAY")
+	}
+}
@@ -0,0 +1,182 @@
+// Package: actions
+// Salida YAML para los comandos con --output yaml
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// yamlImplicitRe textos que un lector YAML 1.1 convierte en fecha, hora o número sexagesimal
+// (2024-01-02, 2024-01-02T10:00:00Z, 1:30)
+var yamlImplicitRe = regexp.MustCompile(`^(\d{4}-\d{1,2}-\d{1,2}([Tt ].*)?|[-+]?\d+(:[0-5]?\d)+(\.\d*)?)$`)
+
+// yamlNode valor intermedio que conserva el orden de las claves del JSON
+type yamlNode struct {
+	scalar string     // Escalar ya formateado (objetos y listas lo dejan vacío)
+	keys   []string   // Claves de un objeto, en orden
+	values []yamlNode // Valores de un objeto o elementos de una lista
+	object bool
+	list   bool
+}
+
+// MarshalYAML convierte v a YAML usando sus etiquetas json
+// Se pasa por JSON para que ambas salidas tengan el mismo esquema y el mismo orden de campos
+func MarshalYAML(v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("error generando YAML: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	node, err := readYAMLNode(dec)
+	if err != nil {
+		return nil, fmt.Errorf("error generando YAML: %w", err)
+	}
+
+	var out strings.Builder
+	switch {
+	case node.object && len(node.keys) > 0:
+		writeYAMLObject(&out, node, 0)
+	case node.list && len(node.values) > 0:
+		writeYAMLList(&out, node, 0)
+	default:
+		out.WriteString(yamlInline(node) + "\n")
+	}
+	return []byte(out.String()), nil
+}
+
+// readYAMLNode lee el siguiente valor del decodificador
+func readYAMLNode(dec *json.Decoder) (yamlNode, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return yamlNode{}, err
+	}
+	switch t := tok.(type) {
+	case json.Delim:
+		node := yamlNode{object: t == '{', list: t == '['}
+		for dec.More() {
+			if node.object {
+				key, err := dec.Token()
+				if err != nil {
+					return yamlNode{}, err
+				}
+				node.keys = append(node.keys, key.(string))
+			}
+			value, err := readYAMLNode(dec)
+			if err != nil {
+				return yamlNode{}, err
+			}
+			node.values = append(node.values, value)
+		}
+		if _, err := dec.Token(); err != nil {
+			return yamlNode{}, err
+		}
+		return node, nil
+	case string:
+		return yamlNode{scalar: yamlString(t)}, nil
+	case json.Number:
+		return yamlNode{scalar: t.String()}, nil
+	case bool:
+		return yamlNode{scalar: fmt.Sprint(t)}, nil
+	default:
+		return yamlNode{scalar: "null"}, nil
+	}
+}
+
+// writeYAMLObject escribe las claves de un objeto con la sangría dada
+func writeYAMLObject(out *strings.Builder, node yamlNode, indent int) {
+	pad := strings.Repeat(" ", indent)
+	for i, key := range node.keys {
+		value := node.values[i]
+		switch {
+		case value.object && len(value.keys) > 0:
+			fmt.Fprintf(out, "%s%s:\n", pad, yamlString(key))
+			writeYAMLObject(out, value, indent+2)
+		case value.list && len(value.values) > 0:
+			fmt.Fprintf(out, "%s%s:\n", pad, yamlString(key))
+			writeYAMLList(out, value, indent+2)
+		default:
+			fmt.Fprintf(out, "%s%s: %s\n", pad, yamlString(key), yamlInline(value))
+		}
+	}
+}
+
+// writeYAMLList escribe los elementos de una lista con la sangría dada
+func writeYAMLList(out *strings.Builder, node yamlNode, indent int) {
+	pad := strings.Repeat(" ", indent)
+	for _, item := range node.values {
+		switch {
+		case item.object && len(item.keys) > 0:
+			// La primera clave va en la línea del guion y el resto alineado con ella
+			var nested strings.Builder
+			writeYAMLObject(&nested, item, indent+2)
+			out.WriteString(pad + "- " + strings.TrimPrefix(nested.String(), pad+"  "))
+		case item.list && len(item.values) > 0:
+			fmt.Fprintf(out, "%s-\n", pad)
+			writeYAMLList(out, item, indent+2)
+		default:
+			fmt.Fprintf(out, "%s- %s\n", pad, yamlInline(item))
+		}
+	}
+}
+
+// yamlInline formatea un escalar, o un objeto o lista vacíos
+func yamlInline(node yamlNode) string {
+	switch {
+	case node.object:
+		return "{}"
+	case node.list:
+		return "[]"
+	}
+	return node.scalar
+}
+
+// yamlString deja el texto sin comillas si YAML lo lee igual; si no, con comillas dobles
+func yamlString(s string) string {
+	if yamlPlainSafe(s) {
+		return s
+	}
+	quoted, _ := json.Marshal(s) // Las comillas dobles de YAML admiten los escapes de JSON
+	return string(quoted)
+}
+
+// yamlPlainSafe indica si un texto puede escribirse sin comillas
+func yamlPlainSafe(s string) bool {
+	if s == "" || s != strings.TrimSpace(s) {
+		return false
+	}
+	switch strings.ToLower(s) {
+	case "true", "false", "yes", "no", "on", "off", "null", "~", "y", "n", ".inf", "-.inf", "+.inf", ".nan":
+		return false
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return false
+	}
+	// 0x1F, 0o17, 0b101 y 1_000
+	if _, err := strconv.ParseInt(s, 0, 64); err == nil {
+		return false
+	}
+	if yamlImplicitRe.MatchString(s) {
+		return false
+	}
+	if strings.ContainsAny(s[:1], "-?:,[]{}#&*!|>'\"%@`") {
+		return false
+	}
+	if strings.Contains(s, ": ") || strings.Contains(s, " #") || strings.HasSuffix(s, ":") {
+		return false
+	}
+	for _, r := range s {
+		if r < 0x20 || r == 0x7f {
+			return false
+		}
+	}
+	return true
+}
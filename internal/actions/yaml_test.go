@@ -0,0 +1,31 @@
+package actions
+
+import "testing"
+
+func TestYAMLString(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"alacritty", "alacritty"},
+		{"0.44.1", "0.44.1"},
+		{"~/.config/alacritty/alacritty.toml", "~/.config/alacritty/alacritty.toml"},
+		{"~", `"~"`},
+		{"true", `"true"`},
+		{"12", `"12"`},
+		{"0x1F", `"0x1F"`},
+		{"0o17", `"0o17"`},
+		{"0b101", `"0b101"`},
+		{"1_000", `"1_000"`},
+		{".inf", `".inf"`},
+		{"2024-01-02", `"2024-01-02"`},
+		{"2024-01-02T10:00:00Z", `"2024-01-02T10:00:00Z"`},
+		{"2024-01-02T10:00:00.123+02:00", `"2024-01-02T10:00:00.123+02:00"`},
+		{"1:30", `"1:30"`},
+		{"a: b", `"a: b"`},
+	}
+	for _, tt := range tests {
+		if got := yamlString(tt.in); got != tt.want {
+			t.Errorf("yamlString(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
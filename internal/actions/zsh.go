@@ -0,0 +1,270 @@
+// Package: actions
+// Acciones de configuración de Zsh
+// author: XebecCorporation
+// version: 1.0.0
+
+package actions
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// Opciones de configuración de Zsh (módulos del fragmento xebec.zsh)
+type ZshConfigOptions struct {
+	History     bool // HISTFILE, tamaño y opciones de historial
+	Completion  bool // compinit con menú y coincidencia sin mayúsculas
+	Keybindings bool // modo emacs, búsqueda en historial, Ctrl+flechas
+	Colors      bool // LS_COLORS / ZLS_COLORS con el tema XEBEC
+	Plugins     bool // zsh-autosuggestions y zsh-syntax-highlighting locales
+}
+
+// GetZshConfigOptions retorna los módulos disponibles para configurar
+func GetZshConfigOptions() []ConfigOption {
+	return []ConfigOption{
+		{ID: "history", Title: "Historial", Description: "50k entradas compartidas entre sesiones, sin duplicados", Key: "history"},
+		{ID: "completion", Title: "Completado", Description: "compinit con menú y sin distinguir mayúsculas", Key: "completion"},
+		{ID: "keybindings", Title: "Atajos", Description: "Modo emacs, búsqueda con ↑↓, Ctrl+←→, Inicio/Fin", Key: "keybindings"},
+		{ID: "colors", Title: "Colores", Description: "LS_COLORS y ZLS_COLORS con el tema XEBEC", Key: "colors"},
+		{ID: "plugins", Title: "Plugins", Description: "zsh-autosuggestions y zsh-syntax-highlighting instalados localmente", Key: "plugins"},
+	}
+}
+
+// ZshOptionsFromIDs construye las opciones a partir de los IDs marcados
+func ZshOptionsFromIDs(ids []string) ZshConfigOptions {
+	return ZshConfigOptions{
+		History:     containsID(ids, "history"),
+		Completion:  containsID(ids, "completion"),
+		Keybindings: containsID(ids, "keybindings"),
+		Colors:      containsID(ids, "colors"),
+		Plugins:     containsID(ids, "plugins"),
+	}
+}
+
+// IsEmpty indica si no se seleccionó ningún módulo
+func (o ZshConfigOptions) IsEmpty() bool {
+	return !o.History && !o.Completion && !o.Keybindings && !o.Colors && !o.Plugins
+}
+
+// modules retorna el mapa de módulos usado por la plantilla
+func (o ZshConfigOptions) modules() map[string]bool {
+	return map[string]bool{
+		"history":     o.History,
+		"completion":  o.Completion,
+		"keybindings": o.Keybindings,
+		"colors":      o.Colors,
+		"plugins":     o.Plugins,
+	}
+}
+
+// ZshPlugin plugin de Zsh cargado desde una instalación local
+type ZshPlugin struct {
+	Name string
+	Path string // Script a cargar; vacío si no se encontró
+}
+
+// zshPluginNames plugins soportados (syntax-highlighting debe cargarse el último)
+var zshPluginNames = []string{"zsh-autosuggestions", "zsh-syntax-highlighting"}
+
+// ZshTemplateData datos para renderizar xebec.zsh
+type ZshTemplateData struct {
+	Modules      map[string]bool
+	FragmentPath string
+	CacheDir     string
+	LSColors     string
+	Palette      TerminalPalette
+	Plugins      []ZshPlugin
+}
+
+// lsColorEntry entrada de LS_COLORS con un color del tema XEBEC
+type lsColorEntry struct {
+	Key  string
+	Hex  string
+	Bold bool
+}
+
+// lsColorEntries colores de LS_COLORS tomados de una paleta de terminal
+func lsColorEntries(p TerminalPalette) []lsColorEntry {
+	return []lsColorEntry{
+		{Key: "di", Hex: p.Normal.Blue, Bold: true}, // Directorios: XEBEC BLUE
+		{Key: "ln", Hex: p.Normal.Cyan},             // Enlaces
+		{Key: "or", Hex: p.Normal.Red},              // Enlaces rotos
+		{Key: "mi", Hex: p.Normal.Red},
+		{Key: "ex", Hex: p.Normal.Green, Bold: true},  // Ejecutables
+		{Key: "pi", Hex: p.Normal.Yellow},             // FIFOs
+		{Key: "so", Hex: p.Bright.Magenta},            // Sockets
+		{Key: "bd", Hex: p.Bright.Yellow, Bold: true}, // Dispositivos de bloque
+		{Key: "cd", Hex: p.Bright.Yellow, Bold: true}, // Dispositivos de carácter
+		{Key: "*.tar", Hex: p.Bright.Red},
+		{Key: "*.tgz", Hex: p.Bright.Red},
+		{Key: "*.gz", Hex: p.Bright.Red},
+		{Key: "*.zip", Hex: p.Bright.Red},
+		{Key: "*.7z", Hex: p.Bright.Red},
+		{Key: "*.png", Hex: p.Normal.Magenta},
+		{Key: "*.jpg", Hex: p.Normal.Magenta},
+		{Key: "*.svg", Hex: p.Normal.Magenta},
+		{Key: "*.md", Hex: p.Foreground, Bold: true},
+	}
+}
+
+// XebecLSColors construye LS_COLORS en truecolor con el tema XEBEC
+func XebecLSColors() string {
+	return LSColorsFor(XebecPalette())
+}
+
+// LSColorsFor construye LS_COLORS en truecolor con una paleta de terminal
+func LSColorsFor(p TerminalPalette) string {
+	entries := lsColorEntries(p)
+	parts := make([]string, 0, len(entries))
+	for _, e := range entries {
+		sgr := hexToSGR(e.Hex)
+		if e.Bold {
+			sgr = "1;" + sgr
+		}
+		parts = append(parts, e.Key+"="+sgr)
+	}
+	return strings.Join(parts, ":")
+}
+
+// hexToSGR convierte #RRGGBB en la secuencia SGR de color de texto 38;2;R;G;B
+func hexToSGR(hex string) string {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) != 6 {
+		return "0"
+	}
+	rgb := make([]string, 3)
+	for i := 0; i < 3; i++ {
+		v, err := strconv.ParseUint(hex[i*2:i*2+2], 16, 8)
+		if err != nil {
+			return "0"
+		}
+		rgb[i] = strconv.FormatUint(v, 10)
+	}
+	return "38;2;" + strings.Join(rgb, ";")
+}
+
+// GetZshFragmentPath retorna la ruta del fragmento xebec.zsh que carga .zshrc
+func GetZshFragmentPath() string {
+	return filepath.Join(xdgConfigHome(), "zsh", "xebec.zsh")
+}
+
+// GetZshSourcePath retorna la ruta de la plantilla base de Zsh
+func GetZshSourcePath() string {
+	_, currentFile, _, _ := runtime.Caller(0)
+	projectRoot := filepath.Dir(filepath.Dir(filepath.Dir(currentFile)))
+	return filepath.Join(projectRoot, "zsh", "xebec.zsh")
+}
+
+// zshPluginDirs directorios locales donde los gestores de paquetes instalan plugins
+func zshPluginDirs(name string) []string {
+	dirs := []string{
+		filepath.Join(userHome(), ".zsh", "plugins", name),
+		filepath.Join(xdgConfigHome(), "zsh", "plugins", name),
+		filepath.Join("/usr/share/zsh/plugins", name), // Arch
+		filepath.Join("/usr/share", name),             // Debian, Ubuntu, Fedora
+	}
+	if prefix := os.Getenv("HOMEBREW_PREFIX"); prefix != "" {
+		dirs = append(dirs, filepath.Join(prefix, "share", name))
+	}
+	return append(dirs,
+		filepath.Join("/opt/homebrew/share", name),
+		filepath.Join("/usr/local/share", name),
+	)
+}
+
+// FindZshPlugins busca los plugins soportados en las rutas locales
+func FindZshPlugins() []ZshPlugin {
+	plugins := make([]ZshPlugin, 0, len(zshPluginNames))
+	for _, name := range zshPluginNames {
+		plugin := ZshPlugin{Name: name}
+		for _, dir := range zshPluginDirs(name) {
+			script := filepath.Join(dir, name+".zsh")
+			if _, err := os.Stat(script); err == nil {
+				plugin.Path = script
+				break
+			}
+		}
+		plugins = append(plugins, plugin)
+	}
+	return plugins
+}
+
+// NewZshTemplateData construye los datos de plantilla para la máquina actual
+func NewZshTemplateData(opts ZshConfigOptions) ZshTemplateData {
+	data := ZshTemplateData{
+		Modules:      opts.modules(),
+		FragmentPath: GetZshFragmentPath(),
+		CacheDir:     filepath.Join(userHome(), ".cache", "zsh"),
+		LSColors:     XebecLSColors(),
+		Palette:      XebecPalette(),
+	}
+	if opts.Plugins {
+		data.Plugins = FindZshPlugins()
+	}
+	return data
+}
+
+// RenderZshConfig genera el contenido de xebec.zsh a partir de la plantilla
+func RenderZshConfig(tmpl string, data ZshTemplateData) (string, error) {
+	return renderTemplate("xebec.zsh", tmpl, data)
+}
+
+// zshSourceBlock carga el fragmento desde .zshrc
+func zshSourceBlock(fragmentPath string) string {
+	return fmt.Sprintf("[[ -r '%s' ]] && source '%s'", fragmentPath, fragmentPath)
+}
+
+// ConfigureZsh genera xebec.zsh y lo carga desde .zshrc dentro de un bloque gestionado
+func ConfigureZsh(opts ZshConfigOptions) (err error) {
+	defer recordConfigured("zsh", opts.modules(), &err)
+
+	// Verificar que Zsh esté instalado
+	if !IsZshInstalled() {
+		return fmt.Errorf("Zsh no está instalado en el sistema")
+	}
+
+	tmpl, err := os.ReadFile(GetZshSourcePath())
+	if err != nil {
+		return fmt.Errorf("error leyendo plantilla xebec.zsh: %w", err)
+	}
+
+	data := NewZshTemplateData(opts)
+	content, err := RenderZshConfig(string(tmpl), data)
+	if err != nil {
+		return fmt.Errorf("error generando configuración: %w", err)
+	}
+
+	for _, p := range data.Plugins {
+		if p.Path == "" {
+			fmt.Printf("⚠ %s no está instalado localmente (p. ej. sudo apt install %s)\n", p.Name, p.Name)
+		}
+	}
+
+	if err := writeFragment(data.FragmentPath, content); err != nil {
+		return err
+	}
+
+	// Cargar el fragmento desde .zshrc
+	return upsertBlocks(GetZshRCPath(), []ManagedBlock{{Component: "zsh", Body: zshSourceBlock(data.FragmentPath)}})
+}
+
+// IsZshInstalled verifica si Zsh está instalado
+func IsZshInstalled() bool {
+	return isAnyBinaryInstalled([]string{"zsh"})
+}
+
+// GetZshStatus retorna el estado actual de Zsh
+func GetZshStatus() (installed bool, configured bool, configPath string) {
+	configPath = GetZshFragmentPath()
+	installed = IsZshInstalled()
+
+	if _, err := os.Stat(configPath); err == nil {
+		configured = true
+	}
+
+	return
+}
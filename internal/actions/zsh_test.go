@@ -0,0 +1,94 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRenderZshConfigGolden(t *testing.T) {
+	goldenHome(t)
+	tmpl, err := os.ReadFile(GetZshSourcePath())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		ids  []string
+	}{
+		{name: "all", ids: Configurator{Options: GetZshConfigOptions}.DefaultSections()},
+		{name: "colors", ids: []string{"colors"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := NewZshTemplateData(ZshOptionsFromIDs(tt.ids))
+			if data.Plugins != nil {
+				// Uno instalado y otro no, sin depender de la máquina
+				data.Plugins = []ZshPlugin{
+					{Name: "zsh-autosuggestions", Path: "/usr/share/zsh-autosuggestions/zsh-autosuggestions.zsh"},
+					{Name: "zsh-syntax-highlighting"},
+				}
+			}
+			out, err := RenderZshConfig(string(tmpl), data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertGolden(t, "zsh-"+tt.name+".zsh", out)
+		})
+	}
+}
+
+func TestFindZshPlugins(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, ".config"))
+	t.Setenv("HOMEBREW_PREFIX", "")
+
+	script := filepath.Join(home, ".zsh", "plugins", "zsh-autosuggestions", "zsh-autosuggestions.zsh")
+	if err := os.MkdirAll(filepath.Dir(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(script, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	plugins := FindZshPlugins()
+	if len(plugins) != len(zshPluginNames) {
+		t.Fatalf("got %d plugins, want %d", len(plugins), len(zshPluginNames))
+	}
+	// syntax-highlighting debe ser el último en cargarse
+	if plugins[0].Name != "zsh-autosuggestions" || plugins[len(plugins)-1].Name != "zsh-syntax-highlighting" {
+		t.Errorf("orden de plugins: %+v", plugins)
+	}
+	if plugins[0].Path != script {
+		t.Errorf("zsh-autosuggestions: Path = %q, want %q", plugins[0].Path, script)
+	}
+}
+
+func TestLSColorsFor(t *testing.T) {
+	colors := LSColorsFor(DefaultTheme().TerminalPalette)
+	if !strings.HasPrefix(colors, "di=1;"+hexToSGR(DefaultTheme().Normal.Blue)+":") {
+		t.Errorf("LS_COLORS sin directorios en azul XEBEC: %s", colors)
+	}
+	for _, entry := range strings.Split(colors, ":") {
+		if _, sgr, ok := strings.Cut(entry, "="); !ok || !strings.Contains(sgr, "38;2;") {
+			t.Errorf("entrada sin color truecolor: %q", entry)
+		}
+	}
+}
+
+func TestHexToSGR(t *testing.T) {
+	tests := map[string]string{
+		"#00AEEF": "38;2;0;174;239",
+		"4CAF50":  "38;2;76;175;80",
+		"#FFF":    "0",
+		"#GGGGGG": "0",
+	}
+	for hex, want := range tests {
+		if got := hexToSGR(hex); got != want {
+			t.Errorf("hexToSGR(%q) = %q, want %q", hex, got, want)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+// Package: os
+// Atributos de proceso en sistemas distintos de Windows
+// author: XebecCorporation
+// version: 1.0.0
+
+//go:build !windows
+
+package os
+
+import "os/exec"
+
+// hideWindow no hace nada fuera de Windows
+func hideWindow(cmd *exec.Cmd) {}
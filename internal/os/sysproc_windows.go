@@ -0,0 +1,18 @@
+// Package: os
+// Atributos de proceso específicos de Windows
+// author: XebecCorporation
+// version: 1.0.0
+
+//go:build windows
+
+package os
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// hideWindow evita que el comando abra una ventana de consola
+func hideWindow(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{HideWindow: true}
+}
@@ -12,20 +12,20 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
-	"syscall"
 	"time"
 )
 
 // Terminal detectado en el sistema
+// Las etiquetas json forman parte del esquema de xebec terminals --output json
 type Terminal struct {
-	ID          string   // "alacritty", "wezterm", etc.
-	Name        string   // Nombre para mostrar
-	Icon        string   // Icono emoji
-	Version     string   // Versión del terminal
-	Installed   bool     // Si está instalado
-	ConfigPath  string   // Ruta de configuración principal
-	ConfigPaths []string // Rutas alternativas de configuración
-	Exists      bool     // Si existe archivo de config
+	ID          string   `json:"id"`            // "alacritty", "wezterm", etc.
+	Name        string   `json:"name"`          // Nombre para mostrar
+	Icon        string   `json:"-"`             // Icono emoji
+	Version     string   `json:"version"`       // Versión del terminal
+	Installed   bool     `json:"installed"`     // Si está instalado
+	ConfigPath  string   `json:"config_path"`   // Ruta de configuración principal
+	ConfigPaths []string `json:"config_paths"`  // Rutas alternativas de configuración
+	Exists      bool     `json:"config_exists"` // Si existe archivo de config
 }
 
 // Lista completa de terminales a detectar
@@ -76,7 +76,7 @@ var terminalList = []struct {
 		name:       "Windows Terminal",
 		icon:       "🪟",
 		commands:   []string{"wt", "wt.exe"},
-		configs:    []func() string{windowsTerminalConfig},
+		configs:    []func() string{WindowsTerminalSettingsPath},
 		versionCmd: "wt --version",
 	},
 	{
@@ -433,11 +433,7 @@ func getTerminalVersion(versionCmd string) string {
 	}
 
 	// Configurar para que no muestre ventana en Windows
-	if runtime.GOOS == "windows" {
-		cmd.SysProcAttr = &syscall.SysProcAttr{
-			HideWindow: true,
-		}
-	}
+	hideWindow(cmd)
 
 	// Timeout de 3 segundos para no bloquear
 	done := make(chan string, 1)
@@ -562,8 +558,21 @@ func ghosttyConfig() string {
 	return ""
 }
 
-func windowsTerminalConfig() string {
-	return filepath.Join(os.Getenv("LOCALAPPDATA"), "Packages", "Microsoft.WindowsTerminal_8wekyb3d8bbwe", "LocalState", "settings.json")
+// WindowsTerminalSettingsPath retorna settings.json de Windows Terminal
+// Prioriza la instalación de Microsoft Store y luego la versión sin empaquetar (scoop, winget portable)
+func WindowsTerminalSettingsPath() string {
+	localAppData := os.Getenv("LOCALAPPDATA")
+	candidates := []string{
+		filepath.Join(localAppData, "Packages", "Microsoft.WindowsTerminal_8wekyb3d8bbwe", "LocalState", "settings.json"),
+		filepath.Join(localAppData, "Packages", "Microsoft.WindowsTerminalPreview_8wekyb3d8bbwe", "LocalState", "settings.json"),
+		filepath.Join(localAppData, "Microsoft", "Windows Terminal", "settings.json"),
+	}
+	for _, p := range candidates {
+		if _, err := os.Stat(p); err == nil {
+			return p
+		}
+	}
+	return candidates[0]
 }
 
 func hyperConfig() string {
@@ -629,7 +638,7 @@ func yakuakeConfig() string {
 }
 
 func xfce4TerminalConfig() string {
-	return filepath.Join(os.Getenv("HOME"), ".config", "xfce4", "terminal", "accels.scm")
+	return filepath.Join(os.Getenv("HOME"), ".config", "xfce4", "terminal", "terminalrc")
 }
 
 func lxterminalConfig() string {
@@ -11,6 +11,8 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/actions"
 )
 
 // Estructuras para el branding
@@ -19,29 +21,14 @@ type Branding struct {
 	Version   string                          `json:"version"`
 	Logo      string                          `json:"logo"`
 	Separator string                          `json:"separator"`
-	Colors    Colors                          `json:"colors"`
+	Colors    Colors                          `json:"-"`
 	Texts     Texts                           `json:"texts"`
 	MenuOpts  []MenuOptionBranding            `json:"menu_options"`
 	Submenus  map[string][]MenuOptionBranding `json:"submenus"`
 }
 
-type Colors struct {
-	Primary       string `json:"primary"`
-	Secondary     string `json:"secondary"`
-	White         string `json:"white"`
-	AccentCyan    string `json:"accent_cyan"`
-	AccentGreen   string `json:"accent_green"`
-	AccentOrange  string `json:"accent_orange"`
-	AccentRed     string `json:"accent_red"`
-	AccentYellow  string `json:"accent_yellow"`
-	AccentPurple  string `json:"accent_purple"`
-	GradientStart string `json:"gradient_start"`
-	GradientEnd   string `json:"gradient_end"`
-	GrayDark      string `json:"gray_dark"`
-	Gray          string `json:"gray"`
-	GrayLight     string `json:"gray_light"`
-	GrayLighter   string `json:"gray_lighter"`
-}
+// Colors acentos de la UI; vienen del tema XEBEC (themes/xebec.json), no de branding.json
+type Colors = actions.UIColors
 
 type Texts struct {
 	CLILabel      string `json:"cli_label"`
@@ -89,6 +76,8 @@ func loadBranding() Branding {
 		return getDefaultBranding()
 	}
 
+	// El tema activo se aplica al abrir el menú (ApplyTheme); aquí no se lee el estado
+	branding.Colors = actions.DefaultTheme().UI
 	return branding
 }
 
@@ -96,26 +85,10 @@ func loadBranding() Branding {
 func getDefaultBranding() Branding {
 	return Branding{
 		Name:      "XEBEC",
+		Colors:    actions.DefaultTheme().UI,
 		Version:   "0.1.0",
 		Logo:      "XEBEC CORPORATION - CLI",
 		Separator: "═══════════════════════════════════════════════════",
-		Colors: Colors{
-			Primary:       "#6366F1",
-			Secondary:     "#0A0A0A",
-			White:         "#FFFFFF",
-			AccentCyan:    "#00BCD4",
-			AccentGreen:   "#4CAF50",
-			AccentOrange:  "#FF9800",
-			AccentRed:     "#F44336",
-			AccentYellow:  "#FFEB3B",
-			AccentPurple:  "#8B5CF6",
-			GradientStart: "#6366F1",
-			GradientEnd:   "#8B5CF6",
-			GrayDark:      "#1a1a1a",
-			Gray:          "#333333",
-			GrayLight:     "#666666",
-			GrayLighter:   "#999999",
-		},
 		Texts: Texts{
 			CLILabel:      "CLI",
 			PlatformLabel: "Platform",
@@ -40,9 +40,23 @@ type CheckboxOption struct {
 	ID          string
 	Title       string
 	Description string
+	Group       string // Opciones del mismo grupo son excluyentes
 	Checked     bool
 }
 
+// toggleCheckboxOption marca/desmarca una opción respetando los grupos excluyentes
+func toggleCheckboxOption(options []CheckboxOption, idx int) {
+	options[idx].Checked = !options[idx].Checked
+	if !options[idx].Checked || options[idx].Group == "" {
+		return
+	}
+	for i := range options {
+		if i != idx && options[i].Group == options[idx].Group {
+			options[i].Checked = false
+		}
+	}
+}
+
 // CheckboxModel modelo para manejar checkboxes
 type CheckboxModel struct {
 	Title       string
@@ -56,13 +70,14 @@ type CheckboxModel struct {
 }
 
 // NewCheckboxModel crea un nuevo modelo de checkbox
-func NewCheckboxModel(title string, options []actions.AlacrittyConfigOption) *CheckboxModel {
+func NewCheckboxModel(title string, options []actions.ConfigOption) *CheckboxModel {
 	checkboxOpts := make([]CheckboxOption, len(options))
 	for i, opt := range options {
 		checkboxOpts[i] = CheckboxOption{
 			ID:          opt.ID,
 			Title:       opt.Title,
 			Description: opt.Description,
+			Group:       opt.Group,
 			Checked:     false, // Por defecto desmarcado
 		}
 	}
@@ -100,7 +115,7 @@ func (m CheckboxModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case " ":
 			// Toggle checkbox (solo para opciones, no para confirmar/cancelar)
 			if m.Selected < len(m.Options) {
-				m.Options[m.Selected].Checked = !m.Options[m.Selected].Checked
+				toggleCheckboxOption(m.Options, m.Selected)
 			}
 		case "enter":
 			// Si estamos en la última opción, es confirmar
@@ -116,7 +131,7 @@ func (m CheckboxModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, tea.Quit
 			} else {
 				// Seleccionar opción
-				m.Options[m.Selected].Checked = !m.Options[m.Selected].Checked
+				toggleCheckboxOption(m.Options, m.Selected)
 			}
 		case "q", "esc", "ctrl+c":
 			m.Cancelled = true
@@ -218,7 +233,7 @@ func (m CheckboxModel) GetSelectedOptions() []CheckboxOption {
 }
 
 // RunCheckboxModel ejecuta el modelo de checkbox y retorna las opciones
-func RunCheckboxModel(title string, options []actions.AlacrittyConfigOption) []CheckboxOption {
+func RunCheckboxModel(title string, options []actions.ConfigOption) []CheckboxOption {
 	model := NewCheckboxModel(title, options)
 
 	p := tea.NewProgram(model)
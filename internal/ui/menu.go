@@ -7,6 +7,7 @@ package ui
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -167,9 +168,15 @@ type MenuModel struct {
 	IsLoading       bool          // Estado de carga
 	LoadingMessage  string        // Mensaje de carga
 	// Checkbox mode
-	IsCheckboxMode  bool             // Si estamos en modo checkbox
-	CheckboxOptions []CheckboxOption // Opciones del checkbox
-	CheckboxTitle   string           // Título del checkbox
+	IsCheckboxMode   bool             // Si estamos en modo checkbox
+	CheckboxOptions  []CheckboxOption // Opciones del checkbox
+	CheckboxTitle    string           // Título del checkbox
+	CheckboxActionID string           // Acción que aplica la selección
+	// Theme mode
+	IsThemeMode     bool                // Si estamos en el gestor de temas
+	ThemeList       []actions.ThemeInfo // Temas instalados
+	ThemeAppearance string              // Apariencia elegida (dark, light, auto)
+	ThemeMessage    string              // Resultado del último cambio de tema
 }
 
 // NewMenuModel crea un nuevo modelo de menú
@@ -269,6 +276,9 @@ func (m MenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	if m.IsCheckboxMode {
 		return m.updateCheckboxMode(msg)
 	}
+	if m.IsThemeMode {
+		return m.updateThemeMode(msg)
+	}
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
@@ -345,6 +355,12 @@ func (m MenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case ExecuteActionMsg:
 		executeMenuAction(msg.ActionID)
 
+	case ThemeSwitchedMsg:
+		// El cambio terminó después de salir del gestor de temas
+		if msg.Theme.Name != "" {
+			ApplyTheme(msg.Theme)
+		}
+
 	case RefreshTerminalsMsg:
 		m.CachedTerminals = os.DetectTerminals()
 		m.IsLoading = false
@@ -384,13 +400,13 @@ func (m MenuModel) updateCheckboxMode(msg tea.Msg) (MenuModel, tea.Cmd) {
 		case " ":
 			// Toggle checkbox (solo para opciones, no para confirmar/cancelar)
 			if m.Selected < len(m.CheckboxOptions) {
-				m.CheckboxOptions[m.Selected].Checked = !m.CheckboxOptions[m.Selected].Checked
+				toggleCheckboxOption(m.CheckboxOptions, m.Selected)
 			}
 		case "enter":
 			// Si estamos en la última opción (Confirmar)
 			if m.Selected == len(m.CheckboxOptions) {
 				// Confirmar - aplicar configuración
-				m.applyAlacrittyConfig()
+				m.applyCheckboxSelection()
 				m.IsCheckboxMode = false
 				m.CheckboxOptions = nil
 				m.CheckboxTitle = ""
@@ -403,7 +419,7 @@ func (m MenuModel) updateCheckboxMode(msg tea.Msg) (MenuModel, tea.Cmd) {
 				m.Selected = 0
 			} else {
 				// Toggle checkbox
-				m.CheckboxOptions[m.Selected].Checked = !m.CheckboxOptions[m.Selected].Checked
+				toggleCheckboxOption(m.CheckboxOptions, m.Selected)
 			}
 		case "q", "esc", "ctrl+c":
 			// Cancelar
@@ -421,45 +437,43 @@ func (m MenuModel) updateCheckboxMode(msg tea.Msg) (MenuModel, tea.Cmd) {
 	return m, nil
 }
 
-// applyAlacrittyConfig aplica la configuración de Alacritty
-func (m *MenuModel) applyAlacrittyConfig() {
-	// Construir opciones seleccionadas
-	opts := actions.AlacrittyConfigOptions{}
-	for _, opt := range m.CheckboxOptions {
-		if opt.Checked {
-			switch opt.ID {
-			case "window":
-				opts.Window = true
-			case "colors":
-				opts.Colors = true
-			case "font":
-				opts.Font = true
-			case "cursor":
-				opts.Cursor = true
-			case "shell":
-				opts.Shell = true
-			}
+// startCheckboxMode activa el modo checkbox para una acción
+func (m *MenuModel) startCheckboxMode(actionID, title string, options []actions.ConfigOption) {
+	m.IsCheckboxMode = true
+	m.CheckboxTitle = title
+	m.CheckboxActionID = actionID
+
+	// Convertir a CheckboxOption
+	m.CheckboxOptions = make([]CheckboxOption, len(options))
+	for i, opt := range options {
+		m.CheckboxOptions[i] = CheckboxOption{
+			ID:          opt.ID,
+			Title:       opt.Title,
+			Description: opt.Description,
+			Group:       opt.Group,
+			Checked:     false, // Por defecto desmarcado
 		}
 	}
+	m.Selected = 0
+}
 
-	if !opts.Window && !opts.Colors && !opts.Font && !opts.Cursor && !opts.Shell {
-		fmt.Println(MutedTextStyle.Render("No se seleccionó ninguna opción"))
-		return
+// checkedIDs retorna los IDs de las opciones marcadas
+func (m *MenuModel) checkedIDs() []string {
+	var ids []string
+	for _, opt := range m.CheckboxOptions {
+		if opt.Checked {
+			ids = append(ids, opt.ID)
+		}
 	}
+	return ids
+}
 
-	// Aplicar configuración
-	fmt.Println()
-	fmt.Println(InfoStyle.Render("Aplicando configuración..."))
-	fmt.Println()
-
-	if err := actions.ConfigureAlacritty(opts); err != nil {
-		fmt.Println(ErrorStyle.Render(fmt.Sprintf("✗ Error: %v", err)))
-		return
+// applyCheckboxSelection aplica la selección según la acción activa
+func (m *MenuModel) applyCheckboxSelection() {
+	if c, mc, ok := findMenuConfigurator(m.CheckboxActionID); ok {
+		applyConfiguratorOptions(c, mc, m.checkedIDs())
 	}
-
-	fmt.Println()
-	fmt.Println(SuccessStyle.Render("✅ Configuración aplicada correctamente"))
-	fmt.Println(MutedTextStyle.Render("Reinicia Alacritty para ver los cambios"))
+	m.CheckboxActionID = ""
 }
 
 // renderCheckboxView renderiza la vista de checkbox
@@ -660,44 +674,18 @@ func (m *MenuModel) handleEnter() (MenuModel, tea.Cmd) {
 		return *m, nil
 	}
 
-	// Manejo especial para terminal_alacritty - activar modo checkbox
-	if option.ID == "terminal_alacritty" {
-		// Verificar si Alacritty está instalado
-		installed, configured, configPath := actions.GetAlacrittyStatus()
-
-		if !installed {
-			fmt.Println(ErrorStyle.Render("✗ Alacritty no está instalado"))
-			fmt.Println(MutedTextStyle.Render("Por favor, instala Alacritty primero."))
-			fmt.Println(MutedTextStyle.Render("En Windows: winget install Alacritty.Alacritty"))
+	// Terminales y shells - activar modo checkbox
+	if c, mc, ok := findMenuConfigurator(option.ID); ok {
+		if !printConfiguratorStatus(c, mc) {
 			return *m, nil
 		}
+		m.startCheckboxMode(option.ID, mc.checkboxTitle(), c.Options())
+		return *m, nil
+	}
 
-		// Mostrar estado
-		fmt.Println()
-		if configured {
-			fmt.Printf("  ✓ Configuración existente: %s\n", configPath)
-		} else {
-			fmt.Println("  ⚠ No hay configuración")
-		}
-		fmt.Println()
-
-		// Activar modo checkbox
-		options := actions.GetAlacrittyConfigOptions()
-		m.IsCheckboxMode = true
-		m.CheckboxTitle = "🖥️ Opciones de Configuración - Alacritty"
-
-		// Convertir a CheckboxOption
-		m.CheckboxOptions = make([]CheckboxOption, len(options))
-		for i, opt := range options {
-			m.CheckboxOptions[i] = CheckboxOption{
-				ID:          opt.ID,
-				Title:       opt.Title,
-				Description: opt.Description,
-				Checked:     false, // Por defecto desmarcado
-			}
-		}
-		m.Selected = 0
-
+	// Gestor de temas - lista con vista previa
+	if option.ID == "theme" {
+		m.startThemeMode()
 		return *m, nil
 	}
 
@@ -794,6 +782,9 @@ func (m MenuModel) View() string {
 	if m.IsCheckboxMode {
 		return m.renderCheckboxView()
 	}
+	if m.IsThemeMode {
+		return m.renderThemeView()
+	}
 
 	width := m.Width
 	if width == 0 {
@@ -1099,49 +1090,41 @@ func executeMenuAction(optionID string) {
 	fmt.Println(MutedTextStyle.Render(getMenuActionDescription(optionID)))
 	fmt.Println()
 
+	if c, mc, ok := findMenuConfigurator(optionID); ok {
+		configureWithOptions(c, mc)
+		return
+	}
+
 	switch optionID {
 	case "terminal_list":
-		showTerminalsTable()
+		ShowTerminalsTable()
 	case "terminal_refresh":
 		fmt.Println(SuccessStyle.Render("🔄 Detectando terminales..."))
-		showTerminalsTable()
-	case "terminal_alacritty":
-		configureAlacrittyWithOptions()
-	case "terminal_wezterm":
-		fmt.Println(WarningStyle.Render("🔥 WezTerm - En fase de implementación"))
-		fmt.Println(MutedTextStyle.Render("Pronto podrás configurar WezTerm desde XEBEC"))
-	case "terminal_kitty":
-		fmt.Println(WarningStyle.Render("🐱 Kitty - En fase de implementación"))
-		fmt.Println(MutedTextStyle.Render("Pronto podrás configurar Kitty desde XEBEC"))
-	case "terminal_windows":
-		fmt.Println(WarningStyle.Render("🪟 Windows Terminal - En fase de implementación"))
-		fmt.Println(MutedTextStyle.Render("Pronto podrás configurar Windows Terminal desde XEBEC"))
-	case "shell_nushell":
-		fmt.Println(SuccessStyle.Render("🐚 Configurando Nushell..."))
-	case "shell_starship":
-		fmt.Println(SuccessStyle.Render("⭐ Configurando Starship..."))
-	case "shell_zsh":
-		fmt.Println(SuccessStyle.Render("🦪 Configurando Zsh..."))
-	case "shell_powershell":
-		fmt.Println(SuccessStyle.Render("💜 Configurando PowerShell..."))
-	case "tools_fzf":
-		fmt.Println(SuccessStyle.Render("🔍 Instalando fzf..."))
-	case "tools_zoxide":
-		fmt.Println(SuccessStyle.Render("📍 Instalando zoxide..."))
-	case "tools_bat":
-		fmt.Println(SuccessStyle.Render("🦇 Instalando bat..."))
-	case "tools_delta":
-		fmt.Println(SuccessStyle.Render("📐 Instalando delta..."))
-	case "tools_eza":
-		fmt.Println(SuccessStyle.Render("📁 Instalando eza..."))
+		ShowTerminalsTable()
+	case "tools_fzf", "tools_zoxide", "tools_bat", "tools_delta", "tools_eza":
+		tool, _ := actions.FindTool(optionID)
+		if err := actions.InstallTool(tool); err != nil {
+			fmt.Println(ErrorStyle.Render(fmt.Sprintf("✗ Error: %v", err)))
+		}
+	case "tools_font":
+		installXebecFont()
 	case "tools_all":
 		fmt.Println(SuccessStyle.Render("✨ Instalando todas las herramientas..."))
+		if err := actions.InstallTools(actions.Tools); err != nil {
+			fmt.Println(ErrorStyle.Render(fmt.Sprintf("✗ Error: %v", err)))
+		}
 	case "status":
-		showStatus()
+		ShowStatus()
 	case "backup":
 		fmt.Println(SuccessStyle.Render("💾 Creando backup..."))
+		if _, err := actions.BackupConfigured(); err != nil {
+			fmt.Println(ErrorStyle.Render(fmt.Sprintf("✗ Error: %v", err)))
+		}
 	case "restore":
 		fmt.Println(SuccessStyle.Render("♻️ Restaurando backup..."))
+		if _, err := actions.RestoreConfigured(); err != nil {
+			fmt.Println(ErrorStyle.Render(fmt.Sprintf("✗ Error: %v", err)))
+		}
 	default:
 		fmt.Println(RenderInfo("Opción no implementada"))
 	}
@@ -1154,16 +1137,27 @@ func getMenuActionTitle(id string) string {
 		"terminal_alacritty": "Alacritty",
 		"terminal_wezterm":   "WezTerm",
 		"terminal_kitty":     "Kitty",
+		"terminal_ghostty":   "Ghostty",
 		"terminal_windows":   "Windows Terminal",
+		"terminal_gnome":     "GNOME Terminal",
+		"terminal_konsole":   "Konsole",
+		"terminal_xfce":      "XFCE Terminal",
+		"terminal_foot":      "foot",
+		"terminal_rio":       "Rio",
+		"terminal_tilix":     "Tilix",
+		"terminal_xterm":     "X resources",
 		"shell_nushell":      "Nushell",
 		"shell_starship":     "Starship",
 		"shell_zsh":          "Zsh",
 		"shell_powershell":   "PowerShell",
+		"shell_bash":         "Bash",
+		"shell_fish":         "Fish",
 		"tools_fzf":          "fzf",
 		"tools_zoxide":       "zoxide",
 		"tools_bat":          "bat",
 		"tools_delta":        "delta",
 		"tools_eza":          "eza",
+		"tools_font":         "JetBrains Mono",
 		"tools_all":          "Todas las herramientas",
 		"status":             "Estado del sistema",
 		"backup":             "Backup",
@@ -1182,16 +1176,27 @@ func getMenuActionDescription(id string) string {
 		"terminal_alacritty": "Aplicando configuración de Alacritty",
 		"terminal_wezterm":   "Aplicando configuración de WezTerm",
 		"terminal_kitty":     "Aplicando configuración de Kitty",
+		"terminal_ghostty":   "Aplicando configuración de Ghostty",
 		"terminal_windows":   "Aplicando configuración de Windows Terminal",
+		"terminal_gnome":     "Aplicando perfil XEBEC de GNOME Terminal",
+		"terminal_konsole":   "Aplicando perfil XEBEC de Konsole",
+		"terminal_xfce":      "Aplicando configuración de XFCE Terminal",
+		"terminal_foot":      "Aplicando configuración de foot",
+		"terminal_rio":       "Aplicando configuración de Rio",
+		"terminal_tilix":     "Aplicando perfil XEBEC de Tilix",
+		"terminal_xterm":     "Aplicando tema XEBEC en ~/.Xresources",
 		"shell_nushell":      "Aplicando configuración de Nushell",
 		"shell_starship":     "Aplicando configuración de Starship",
 		"shell_zsh":          "Aplicando configuración de Zsh",
 		"shell_powershell":   "Aplicando configuración de PowerShell",
+		"shell_bash":         "Aplicando configuración de Bash",
+		"shell_fish":         "Aplicando configuración de Fish",
 		"tools_fzf":          "Instalando fzf - Buscador fuzzy",
 		"tools_zoxide":       "Instalando zoxide - Navegador de directorios",
 		"tools_bat":          "Instalando bat - Reemplazo de cat",
 		"tools_delta":        "Instalando delta - Pager para git",
 		"tools_eza":          "Instalando eza - Reemplazo de ls",
+		"tools_font":         "Instalando la fuente JetBrains Mono",
 		"tools_all":          "Instalando todas las herramientas del ecosistema",
 		"status":             "Mostrando estado de configuraciones",
 		"backup":             "Creando copia de seguridad",
@@ -1263,8 +1268,8 @@ func renderTerminalTable(contentWidth int) string {
 	return result
 }
 
-// showTerminalsTable muestra una tabla con los terminales (para ejecutar como acción)
-func showTerminalsTable() {
+// ShowTerminalsTable muestra una tabla con los terminales (para ejecutar como acción)
+func ShowTerminalsTable() {
 	terminals := os.DetectTerminals()
 	supportedTerminals := os.GetSupportedTerminals()
 
@@ -1304,8 +1309,25 @@ func showTerminalsTable() {
 	fmt.Println(MutedTextStyle.Render("  Leyenda: ✅ Disponible  ⚙️ Instalado  ❌ No disponible"))
 }
 
-// Mostrar estado del sistema
-func showStatus() {
+// installXebecFont instala JetBrains Mono si no está instalada
+func installXebecFont() {
+	font := actions.XebecFont
+	if where, ok := actions.DetectFont(font.Family); ok {
+		fmt.Println(SuccessStyle.Render(fmt.Sprintf("✓ %s ya está instalada (%s)", font.Family, where)))
+		return
+	}
+
+	files, err := actions.InstallFont(font, "")
+	if err != nil {
+		fmt.Println(ErrorStyle.Render(fmt.Sprintf("✗ Error: %v", err)))
+		return
+	}
+	fmt.Println(SuccessStyle.Render(fmt.Sprintf("✅ %s instalada (%d archivos)", font.Family, len(files))))
+	fmt.Println(MutedTextStyle.Render("Reinicia el terminal para usar la fuente"))
+}
+
+// ShowStatus muestra el sistema, los terminales detectados y los bloques gestionados
+func ShowStatus() {
 	fmt.Println(TitleStyle.Render("📊 Estado del Sistema"))
 	fmt.Println()
 
@@ -1328,6 +1350,67 @@ func showStatus() {
 		}
 		fmt.Printf("  %s %s - %s\n", t.Icon, t.Name, status)
 	}
+
+	showManagedBlocks()
+
+	drifts, err := actions.DetectDrift()
+	if err != nil {
+		fmt.Println(ErrorStyle.Render(fmt.Sprintf("✗ Error: %v", err)))
+		return
+	}
+	ShowDriftTable(drifts)
+}
+
+// driftLabels icono y texto de cada estado de drift
+var driftLabels = map[string]string{
+	actions.DriftInSync:           "✅ Sincronizado",
+	actions.DriftUserModified:     "✏️ Editado",
+	actions.DriftOutdatedTemplate: "🔄 Plantilla nueva",
+	actions.DriftMissing:          "❌ No existe",
+}
+
+// ShowDriftTable muestra cada archivo gestionado comparado con lo que escribió XEBEC
+func ShowDriftTable(drifts []actions.FileDrift) {
+	fmt.Println()
+	fmt.Println(TitleStyle.Render("🔍 Archivos Gestionados"))
+	fmt.Println()
+	if len(drifts) == 0 {
+		fmt.Println(MutedTextStyle.Render("  Sin historial: configura alguna herramienta con xebec"))
+		return
+	}
+	fmt.Printf("  %-16s │ %-20s │ %s\n", "Herramienta", "Estado", "Archivo")
+	fmt.Printf("  %s\n", strings.Repeat("─", 72))
+	for _, d := range drifts {
+		fmt.Printf("  %-16s │ %-20s │ %s\n", d.Tool, driftLabels[d.Status], d.Label())
+	}
+	fmt.Println()
+	fmt.Println(MutedTextStyle.Render("  Leyenda: ✏️ Editado a mano  🔄 Sin editar, la plantilla cambió  ❌ Borrado"))
+	fmt.Println(MutedTextStyle.Render("  Diferencias: xebec status --drift --diff"))
+}
+
+// showManagedBlocks muestra los bloques xebec de cada archivo y si fueron editados a mano
+func showManagedBlocks() {
+	fmt.Println()
+	fmt.Println(TitleStyle.Render("🧩 Bloques Gestionados"))
+	found := false
+	for _, path := range actions.ManagedBlockFiles() {
+		blocks, err := actions.ListBlocksInFile(path)
+		if err != nil {
+			fmt.Println(ErrorStyle.Render(fmt.Sprintf("  ✗ %v", err)))
+			continue
+		}
+		for _, b := range blocks {
+			found = true
+			status := "✅ Sincronizado"
+			if b.Status == actions.BlockModified {
+				status = "⚠️ Modificado a mano"
+			}
+			fmt.Printf("  xebec:%s en %s - %s\n", b.Component, path, status)
+		}
+	}
+	if !found {
+		fmt.Println(MutedTextStyle.Render("  Sin bloques gestionados"))
+	}
 }
 
 // Configurar Alacritty
@@ -1350,81 +1433,248 @@ func configureAlacritty() {
 	fmt.Println(MutedTextStyle.Render("Usa 'xebec install tools' para instalar herramientas"))
 }
 
-// configureAlacrittyWithOptions configura Alacritty con opciones de checkbox
-func configureAlacrittyWithOptions() {
-	fmt.Println()
-	fmt.Println(TitleStyle.Render("🖥️ Configurar Alacritty"))
-	fmt.Println()
+// menuConfigurator datos del menú de un configurador
+// Opciones, estado y Apply salen de actions.Configurators; aquí solo lo que se muestra
+type menuConfigurator struct {
+	Icon        string
+	Name        string // Vacío: el nombre del configurador
+	Checkbox    string // Título del checkbox, antes del nombre
+	InstallHint string // Vacío: no se comprueba la instalación (PowerShell muestra cada edición)
+	Profile     string // Terminales con perfil XEBEC: qué archivo lo guarda; vacío en el resto
+	Details     func() // Líneas de estado adicionales
+	Reload      string // Cómo ver los cambios
+	AutoReload  string // Sección que ya recarga el terminal; si se marca no se muestra Reload
+}
 
-	// Verificar si Alacritty está instalado
-	installed, configured, configPath := actions.GetAlacrittyStatus()
+// menuConfigurators datos del menú por ID de menú
+var menuConfigurators = map[string]menuConfigurator{
+	"terminal_alacritty": {
+		Icon: "🖥️", Checkbox: "Opciones de Configuración",
+		InstallHint: "En Windows: winget install Alacritty.Alacritty",
+		Reload:      "Reinicia Alacritty para ver los cambios",
+	},
+	"terminal_wezterm": {
+		Icon: "🔥", Checkbox: "Opciones de Configuración",
+		InstallHint: "En Windows: winget install wez.wezterm",
+		Details:     func() { fmt.Printf("  • wezterm.lua: %s\n", actions.GetWezTermConfigPath()) },
+		Reload:      "WezTerm recarga la configuración automáticamente",
+	},
+	"terminal_kitty": {
+		Icon: "🐱", Checkbox: "Opciones de Configuración",
+		InstallHint: "En Linux/macOS: curl -L https://sw.kovidgoyal.net/kitty/installer.sh | sh /dev/stdin",
+		Details:     func() { fmt.Printf("  • kitty.conf: %s\n", actions.GetKittyConfigPath()) },
+		Reload:      "Recarga Kitty con ctrl+shift+F5 para ver los cambios",
+		AutoReload:  "reload",
+	},
+	"terminal_ghostty": {
+		Icon: "👻", Checkbox: "Opciones de Configuración",
+		InstallHint: "En macOS: brew install --cask ghostty",
+		Details:     func() { fmt.Printf("  • config: %s\n", actions.GetGhosttyConfigPath()) },
+		Reload:      "Recarga Ghostty (ctrl+shift+, o cmd+shift+,) para ver los cambios",
+	},
+	"terminal_windows": {
+		Icon: "🪟", Checkbox: "Opciones de Configuración",
+		InstallHint: "En Windows: winget install Microsoft.WindowsTerminal",
+		Profile:     "settings.json",
+		Reload:      "Windows Terminal recarga settings.json automáticamente",
+	},
+	"terminal_gnome": {
+		Icon: "🐧", Checkbox: "Opciones de Configuración",
+		InstallHint: "En Debian/Ubuntu: sudo apt install gnome-terminal",
+		Profile:     "Perfil dconf",
+		Reload:      "Elige el perfil XEBEC en Preferencias de GNOME Terminal",
+	},
+	"terminal_konsole": {
+		Icon: "🐉", Checkbox: "Opciones de Configuración",
+		InstallHint: "En Debian/Ubuntu: sudo apt install konsole",
+		Profile:     "Perfil",
+		Reload:      "Elige el perfil XEBEC en Configuración > Gestionar perfiles",
+	},
+	"terminal_xfce": {
+		Icon: "🐭", Checkbox: "Opciones de Configuración",
+		InstallHint: "En Debian/Ubuntu: sudo apt install xfce4-terminal",
+		Profile:     "terminalrc",
+		Reload:      "Abre una nueva ventana de xfce4-terminal para ver los cambios",
+	},
+	"terminal_foot": {
+		Icon: "🦶", Checkbox: "Opciones de Configuración",
+		InstallHint: "En Debian/Ubuntu: sudo apt install foot",
+		Profile:     "foot.ini",
+		Reload:      "Abre una nueva ventana de foot para ver los cambios",
+	},
+	"terminal_rio": {
+		Icon: "🌊", Checkbox: "Opciones de Configuración",
+		InstallHint: "En macOS: brew install --cask rio",
+		Profile:     "Tema",
+		Reload:      "Rio recarga config.toml automáticamente",
+	},
+	"terminal_tilix": {
+		Icon: "🧩", Checkbox: "Opciones de Configuración",
+		InstallHint: "En Debian/Ubuntu: sudo apt install tilix",
+		Profile:     "Perfil dconf",
+		Reload:      "Elige el perfil XEBEC en Preferencias de Tilix",
+	},
+	"terminal_xterm": {
+		Icon: "❎", Name: "xterm / urxvt", Checkbox: "Opciones de Configuración",
+		InstallHint: "En Debian/Ubuntu: sudo apt install xterm",
+		Profile:     "~/.Xresources",
+		Reload:      "Abre una nueva ventana de xterm/urxvt para ver los cambios",
+	},
+	"shell_nushell": {
+		Icon: "🐚", Checkbox: "Módulos de Configuración",
+		InstallHint: "En Windows: winget install Nushell.Nushell",
+		Reload:      "Abre una nueva sesión de Nushell para ver los cambios",
+	},
+	"shell_starship": {
+		Icon: "⭐", Checkbox: "Módulos y Layout",
+		InstallHint: "En Windows: winget install Starship.Starship",
+		Details: func() {
+			for _, shell := range actions.DetectShells() {
+				var paths []string
+				for _, block := range actions.StarshipInitBlocks(shell) {
+					paths = append(paths, block.Path)
+				}
+				fmt.Printf("  • %s: %s\n", shell.Name, strings.Join(paths, ", "))
+			}
+		},
+		Reload: "Abre una nueva sesión del shell para ver el prompt",
+	},
+	"shell_zsh": {
+		Icon: "🦪", Checkbox: "Módulos de Configuración",
+		InstallHint: "En Debian/Ubuntu: sudo apt install zsh",
+		Details:     func() { fmt.Printf("  • Cargado desde: %s\n", actions.GetZshRCPath()) },
+		Reload:      "Abre una nueva sesión de Zsh para ver los cambios",
+	},
+	"shell_bash": {
+		Icon: "💲", Checkbox: "Módulos de Configuración",
+		InstallHint: "En Debian/Ubuntu: sudo apt install bash",
+		Details:     func() { fmt.Printf("  • Cargado desde: %s\n", actions.GetBashRCPath()) },
+		Reload:      "Abre una nueva sesión de Bash para ver los cambios",
+	},
+	"shell_fish": {
+		Icon: "🐟", Checkbox: "Módulos de Configuración",
+		InstallHint: "En Debian/Ubuntu: sudo apt install fish",
+		Details:     func() { fmt.Printf("  • config.fish: %s\n", actions.GetFishConfigPath()) },
+		Reload:      "Abre una nueva sesión de Fish para ver los cambios",
+	},
+	"shell_powershell": {
+		Icon: "💜", Checkbox: "Bloques del Perfil",
+		Details: func() {
+			for _, profile := range actions.GetPowerShellProfiles() {
+				status := "⚠ No instalado"
+				if profile.Installed {
+					status = "✓ Instalado"
+				}
+				fmt.Printf("  %s %s: %s\n", status, profile.Edition, profile.Path)
+			}
+		},
+		Reload: "Abre una nueva sesión de PowerShell o ejecuta . $PROFILE",
+	},
+}
 
-	if !installed {
-		fmt.Println(ErrorStyle.Render("✗ Alacritty no está instalado"))
-		fmt.Println(MutedTextStyle.Render("Por favor, instala Alacritty primero."))
-		fmt.Println(MutedTextStyle.Render("En Windows: winget install Alacritty.Alacritty"))
-		return
+// findMenuConfigurator busca el configurador de una opción del menú y sus datos de menú
+func findMenuConfigurator(menuID string) (actions.Configurator, menuConfigurator, bool) {
+	meta, ok := menuConfigurators[menuID]
+	if !ok {
+		return actions.Configurator{}, menuConfigurator{}, false
+	}
+	c, ok := actions.FindConfigurator(menuID)
+	if !ok || c.MenuID != menuID {
+		return actions.Configurator{}, menuConfigurator{}, false
+	}
+	if meta.Name == "" {
+		meta.Name = c.Name
+	}
+	return c, meta, true
+}
+
+// checkboxTitle título del checkbox de un configurador
+func (mc menuConfigurator) checkboxTitle() string {
+	return fmt.Sprintf("%s %s - %s", mc.Icon, mc.Checkbox, mc.Name)
+}
+
+// printConfiguratorStatus muestra el estado de un configurador; retorna false si no está instalado
+func printConfiguratorStatus(c actions.Configurator, mc menuConfigurator) bool {
+	installed, configured, configPath := c.Status()
+
+	if mc.InstallHint != "" && !installed {
+		fmt.Println(ErrorStyle.Render(fmt.Sprintf("✗ %s no está instalado", mc.Name)))
+		fmt.Println(MutedTextStyle.Render(fmt.Sprintf("Por favor, instala %s primero.", mc.Name)))
+		fmt.Println(MutedTextStyle.Render(mc.InstallHint))
+		return false
 	}
 
-	// Mostrar estado actual
-	fmt.Println(InfoStyle.Render("Estado de Alacritty:"))
-	if configured {
+	fmt.Println()
+	switch {
+	case mc.InstallHint == "":
+		// Sin estado único: Details lo muestra (ediciones de PowerShell)
+	case configured && mc.Profile != "":
+		fmt.Printf("  ✓ Perfil XEBEC existente: %s\n", configPath)
+	case configured:
 		fmt.Printf("  ✓ Configuración existente: %s\n", configPath)
-	} else {
+	case mc.Profile != "":
+		fmt.Printf("  ⚠ No hay perfil XEBEC (%s: %s)\n", mc.Profile, configPath)
+	default:
 		fmt.Println("  ⚠ No hay configuración")
 	}
+	if mc.Details != nil {
+		mc.Details()
+	}
+	fmt.Println()
+	return true
+}
+
+// configureWithOptions configura una herramienta eligiendo sus secciones con checkbox
+func configureWithOptions(c actions.Configurator, mc menuConfigurator) {
 	fmt.Println()
+	fmt.Println(TitleStyle.Render(fmt.Sprintf("%s Configurar %s", mc.Icon, mc.Name)))
 
-	// Obtener opciones de configuración
-	options := actions.GetAlacrittyConfigOptions()
+	if !printConfiguratorStatus(c, mc) {
+		return
+	}
 
-	// Ejecutar checkbox
 	fmt.Println(MutedTextStyle.Render("Selecciona las opciones a configurar:"))
 	fmt.Println(MutedTextStyle.Render("(Usa ↑↓ para navegar, Espacio para marcar)"))
 	fmt.Println()
 
-	selected := RunCheckboxModel("🖥️ Opciones de Configuración - Alacritty", options)
+	selected := RunCheckboxModel(mc.checkboxTitle(), c.Options())
 
 	if selected == nil {
 		fmt.Println(MutedTextStyle.Render("Configuración cancelada"))
 		return
 	}
 
-	// Construir opciones seleccionadas
-	opts := actions.AlacrittyConfigOptions{}
+	var ids []string
 	for _, s := range selected {
-		switch s.ID {
-		case "window":
-			opts.Window = true
-		case "colors":
-			opts.Colors = true
-		case "font":
-			opts.Font = true
-		case "cursor":
-			opts.Cursor = true
-		case "shell":
-			opts.Shell = true
+		if s.Checked {
+			ids = append(ids, s.ID)
 		}
 	}
 
-	if !opts.Window && !opts.Colors && !opts.Font && !opts.Cursor && !opts.Shell {
+	applyConfiguratorOptions(c, mc, ids)
+}
+
+// applyConfiguratorOptions aplica las secciones elegidas y muestra el resultado
+func applyConfiguratorOptions(c actions.Configurator, mc menuConfigurator, ids []string) {
+	if len(ids) == 0 {
 		fmt.Println(MutedTextStyle.Render("No se seleccionó ninguna opción"))
 		return
 	}
 
-	// Aplicar configuración
 	fmt.Println()
 	fmt.Println(InfoStyle.Render("Aplicando configuración..."))
 	fmt.Println()
 
-	if err := actions.ConfigureAlacritty(opts); err != nil {
+	if err := c.Apply(ids); err != nil {
 		fmt.Println(ErrorStyle.Render(fmt.Sprintf("✗ Error: %v", err)))
 		return
 	}
 
 	fmt.Println()
 	fmt.Println(SuccessStyle.Render("✅ Configuración aplicada correctamente"))
-	fmt.Println(MutedTextStyle.Render("Reinicia Alacritty para ver los cambios"))
+	if mc.AutoReload == "" || !slices.Contains(ids, mc.AutoReload) {
+		fmt.Println(MutedTextStyle.Render(mc.Reload))
+	}
 }
 
 // showTerminalSelection - legacy
@@ -0,0 +1,27 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/actions"
+)
+
+// Cada configurador tiene sus datos de menú y al revés: si falta uno, la opción
+// acaba en "Opción no implementada"
+func TestMenuConfigurators(t *testing.T) {
+	for _, c := range actions.Configurators {
+		_, mc, ok := findMenuConfigurator(c.MenuID)
+		if !ok {
+			t.Errorf("%s: sin datos de menú para %s", c.ID, c.MenuID)
+			continue
+		}
+		if mc.Icon == "" || mc.Checkbox == "" || mc.Reload == "" {
+			t.Errorf("%s: datos de menú incompletos: %+v", c.MenuID, mc)
+		}
+	}
+	for menuID := range menuConfigurators {
+		if c, ok := actions.FindConfigurator(menuID); !ok || c.MenuID != menuID {
+			t.Errorf("%s: sin configurador", menuID)
+		}
+	}
+}
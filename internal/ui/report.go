@@ -0,0 +1,92 @@
+// Package: ui
+// Estado del sistema en formato estable para xebec status --output json|yaml
+// author: XebecCorporation
+// version: 1.0.0
+
+package ui
+
+import (
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/actions"
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/os"
+)
+
+// ReportSchemaVersion versión del esquema de StatusReport
+// Añadir campos no la cambia; renombrar o quitar campos sí
+const ReportSchemaVersion = 1
+
+// StatusReport estado completo de la estación de trabajo
+type StatusReport struct {
+	SchemaVersion int                 `json:"schema_version"`
+	Xebec         string              `json:"xebec"` // Versión del CLI
+	System        SystemInfo          `json:"system"`
+	Theme         ReportTheme         `json:"theme"`
+	Terminals     []os.Terminal       `json:"terminals"`
+	Configured    map[string][]string `json:"configured"` // Herramienta -> secciones aplicadas por última vez
+	Tools         []ReportTool        `json:"tools"`
+	Files         []actions.FileDrift `json:"files"` // Archivos gestionados y su drift
+}
+
+// ReportTheme tema activo
+type ReportTheme struct {
+	Name       string `json:"name"`
+	Appearance string `json:"appearance"`
+}
+
+// ReportTool herramienta de línea de comandos del ecosistema
+type ReportTool struct {
+	ID        string `json:"id"`
+	Installed bool   `json:"installed"`
+	Version   string `json:"version"`
+	Source    string `json:"source"` // Gestor que la instaló, "path" o vacío si no está instalada
+}
+
+// BuildStatusReport reúne el estado del sistema para serializarlo
+func BuildStatusReport(xebecVersion string) (StatusReport, error) {
+	state, err := actions.LoadState()
+	if err != nil {
+		return StatusReport{}, err
+	}
+	drifts, err := actions.DetectDrift()
+	if err != nil {
+		return StatusReport{}, err
+	}
+
+	report := StatusReport{
+		SchemaVersion: ReportSchemaVersion,
+		Xebec:         xebecVersion,
+		System:        DetectSystem(),
+		Theme:         ReportTheme{Name: state.Theme, Appearance: state.Appearance},
+		Terminals:     ReportTerminals(),
+		Configured:    state.Configured,
+		Tools:         []ReportTool{},
+		Files:         drifts,
+	}
+	if report.Configured == nil {
+		report.Configured = map[string][]string{}
+	}
+	for _, t := range actions.Tools {
+		tool := ReportTool{ID: t.ID, Installed: t.IsInstalled()}
+		if tool.Installed {
+			tool.Version = t.Version()
+			tool.Source, _ = t.Source()
+		}
+		report.Tools = append(report.Tools, tool)
+	}
+	return report, nil
+}
+
+// ReportTerminals detecta los terminales con las listas vacías como [] y no null
+// Sin la entrada "none" que DetectTerminals añade para la tabla: sin terminales la lista es []
+func ReportTerminals() []os.Terminal {
+	terminals := []os.Terminal{}
+	for _, t := range os.DetectTerminals() {
+		if t.ID == "none" {
+			continue
+		}
+		if t.ConfigPaths == nil {
+			t.ConfigPaths = []string{}
+		}
+		terminals = append(terminals, t)
+	}
+	return terminals
+}
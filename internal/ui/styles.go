@@ -6,104 +6,168 @@
 package ui
 
 import (
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/actions"
 	"github.com/charmbracelet/lipgloss"
 )
 
-// Colores corporativos XEBEC (cargados desde branding)
+// Colores corporativos XEBEC (cargados del tema activo, por defecto themes/xebec.json)
 var (
 	// Primary colors
-	CorporateBlue  = lipgloss.Color(BrandingConfig.Colors.Primary)
-	CorporateBlack = lipgloss.Color(BrandingConfig.Colors.Secondary)
-	CorporateWhite = lipgloss.Color(BrandingConfig.Colors.White)
+	CorporateBlue  lipgloss.Color
+	CorporateBlack lipgloss.Color
+	CorporateWhite lipgloss.Color
 
 	// Accent colors
-	AccentCyan   = lipgloss.Color(BrandingConfig.Colors.AccentCyan)
-	AccentGreen  = lipgloss.Color(BrandingConfig.Colors.AccentGreen)
-	AccentOrange = lipgloss.Color(BrandingConfig.Colors.AccentOrange)
-	AccentRed    = lipgloss.Color(BrandingConfig.Colors.AccentRed)
-	AccentYellow = lipgloss.Color(BrandingConfig.Colors.AccentYellow)
-	AccentPurple = lipgloss.Color(BrandingConfig.Colors.AccentPurple)
+	AccentCyan   lipgloss.Color
+	AccentGreen  lipgloss.Color
+	AccentOrange lipgloss.Color
+	AccentRed    lipgloss.Color
+	AccentYellow lipgloss.Color
+	AccentPurple lipgloss.Color
 
 	// Gradient colors
-	GradientStart = lipgloss.Color(BrandingConfig.Colors.GradientStart)
-	GradientEnd   = lipgloss.Color(BrandingConfig.Colors.GradientEnd)
+	GradientStart lipgloss.Color
+	GradientEnd   lipgloss.Color
 
 	// Neutral colors
-	GrayDark    = lipgloss.Color(BrandingConfig.Colors.GrayDark)
-	Gray        = lipgloss.Color(BrandingConfig.Colors.Gray)
-	GrayLight   = lipgloss.Color(BrandingConfig.Colors.GrayLight)
-	GrayLighter = lipgloss.Color(BrandingConfig.Colors.GrayLighter)
+	GrayDark    lipgloss.Color
+	Gray        lipgloss.Color
+	GrayLight   lipgloss.Color
+	GrayLighter lipgloss.Color
 )
 
 // Estilos reutilizables
 var (
 	// Estilo para el título principal
-	TitleStyle = lipgloss.NewStyle().
-			Foreground(CorporateBlue).
-			Bold(true).
-			Padding(0, 1)
+	TitleStyle lipgloss.Style
 
 	// Estilo para subtítulos
-	SubtitleStyle = lipgloss.NewStyle().
-			Foreground(CorporateWhite).
-			Bold(false)
+	SubtitleStyle lipgloss.Style
 
 	// Estilo para texto normal
-	NormalTextStyle = lipgloss.NewStyle().
-			Foreground(CorporateWhite)
+	NormalTextStyle lipgloss.Style
 
 	// Estilo para texto muted
-	MutedTextStyle = lipgloss.NewStyle().
-			Foreground(GrayLighter)
+	MutedTextStyle lipgloss.Style
 
 	// Estilo para highlight
-	HighlightStyle = lipgloss.NewStyle().
-			Foreground(CorporateBlue).
-			Bold(true)
+	HighlightStyle lipgloss.Style
 
 	// Estilo para éxito
-	SuccessStyle = lipgloss.NewStyle().
-			Foreground(AccentGreen)
+	SuccessStyle lipgloss.Style
 
 	// Estilo para error
-	ErrorStyle = lipgloss.NewStyle().
-			Foreground(AccentRed)
+	ErrorStyle lipgloss.Style
 
 	// Estilo para warning
-	WarningStyle = lipgloss.NewStyle().
-			Foreground(AccentOrange)
+	WarningStyle lipgloss.Style
 
 	// Estilo para opciones de menú seleccionadas
-	SelectedOptionStyle = lipgloss.NewStyle().
-				Foreground(CorporateBlue).
-				Bold(true).
-				Background(GrayDark)
+	SelectedOptionStyle lipgloss.Style
 
 	// Estilo para opciones de menú no seleccionadas
-	UnselectedOptionStyle = lipgloss.NewStyle().
-				Foreground(GrayLight)
+	UnselectedOptionStyle lipgloss.Style
 
 	// Estilo para bordes
-	BorderStyle = lipgloss.NewStyle().
-			BorderStyle(lipgloss.RoundedBorder()).
-			BorderForeground(CorporateBlue)
+	BorderStyle lipgloss.Style
 
 	// Estilo para caja con borde
-	BoxStyle = lipgloss.NewStyle().
-			BorderStyle(lipgloss.RoundedBorder()).
-			BorderForeground(CorporateBlue).
-			Padding(1, 2)
+	BoxStyle lipgloss.Style
 
 	// Estilo para información del sistema
-	InfoStyle = lipgloss.NewStyle().
-			Foreground(GrayLighter).
-			Padding(0, 1)
+	InfoStyle lipgloss.Style
 
 	// Estilo para separadores
-	SeparatorStyle = lipgloss.NewStyle().
-			Foreground(Gray)
+	SeparatorStyle lipgloss.Style
 
 	// Estilo para el prompt
-	PromptStyle = lipgloss.NewStyle().
-			Foreground(CorporateBlue)
+	PromptStyle lipgloss.Style
 )
+
+// Los estilos arrancan con el tema integrado; el activo se aplica al abrir el menú
+func init() {
+	applyColors(BrandingConfig.Colors)
+}
+
+// ApplyTheme cambia los colores de la interfaz a los del tema indicado
+func ApplyTheme(theme actions.Theme) {
+	BrandingConfig.Colors = theme.UI
+	applyColors(theme.UI)
+}
+
+// applyColors recalcula colores y estilos a partir de los acentos del tema
+func applyColors(c Colors) {
+	CorporateBlue = lipgloss.Color(c.Primary)
+	CorporateBlack = lipgloss.Color(c.Secondary)
+	CorporateWhite = lipgloss.Color(c.White)
+
+	AccentCyan = lipgloss.Color(c.AccentCyan)
+	AccentGreen = lipgloss.Color(c.AccentGreen)
+	AccentOrange = lipgloss.Color(c.AccentOrange)
+	AccentRed = lipgloss.Color(c.AccentRed)
+	AccentYellow = lipgloss.Color(c.AccentYellow)
+	AccentPurple = lipgloss.Color(c.AccentPurple)
+
+	GradientStart = lipgloss.Color(c.GradientStart)
+	GradientEnd = lipgloss.Color(c.GradientEnd)
+
+	GrayDark = lipgloss.Color(c.GrayDark)
+	Gray = lipgloss.Color(c.Gray)
+	GrayLight = lipgloss.Color(c.GrayLight)
+	GrayLighter = lipgloss.Color(c.GrayLighter)
+
+	TitleStyle = lipgloss.NewStyle().
+		Foreground(CorporateBlue).
+		Bold(true).
+		Padding(0, 1)
+
+	SubtitleStyle = lipgloss.NewStyle().
+		Foreground(CorporateWhite).
+		Bold(false)
+
+	NormalTextStyle = lipgloss.NewStyle().
+		Foreground(CorporateWhite)
+
+	MutedTextStyle = lipgloss.NewStyle().
+		Foreground(GrayLighter)
+
+	HighlightStyle = lipgloss.NewStyle().
+		Foreground(CorporateBlue).
+		Bold(true)
+
+	SuccessStyle = lipgloss.NewStyle().
+		Foreground(AccentGreen)
+
+	ErrorStyle = lipgloss.NewStyle().
+		Foreground(AccentRed)
+
+	WarningStyle = lipgloss.NewStyle().
+		Foreground(AccentOrange)
+
+	SelectedOptionStyle = lipgloss.NewStyle().
+		Foreground(CorporateBlue).
+		Bold(true).
+		Background(GrayDark)
+
+	UnselectedOptionStyle = lipgloss.NewStyle().
+		Foreground(GrayLight)
+
+	BorderStyle = lipgloss.NewStyle().
+		BorderStyle(lipgloss.RoundedBorder()).
+		BorderForeground(CorporateBlue)
+
+	BoxStyle = lipgloss.NewStyle().
+		BorderStyle(lipgloss.RoundedBorder()).
+		BorderForeground(CorporateBlue).
+		Padding(1, 2)
+
+	InfoStyle = lipgloss.NewStyle().
+		Foreground(GrayLighter).
+		Padding(0, 1)
+
+	SeparatorStyle = lipgloss.NewStyle().
+		Foreground(Gray)
+
+	PromptStyle = lipgloss.NewStyle().
+		Foreground(CorporateBlue)
+}
@@ -14,10 +14,10 @@ import (
 
 // Información del sistema operativo
 type SystemInfo struct {
-	OS           string
-	Architecture string
-	Platform     string
-	PackageMgr   string
+	OS           string `json:"os"`
+	Architecture string `json:"architecture"`
+	Platform     string `json:"platform"`
+	PackageMgr   string `json:"package_manager"`
 }
 
 // Detectar información del sistema
@@ -0,0 +1,257 @@
+// Package: ui
+// Gestor de temas: lista de temas instalados con vista previa en vivo
+// author: XebecCorporation
+// version: 1.0.0
+
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/XebecCorporation/XebecCorporation.Dots/internal/actions"
+)
+
+// ThemeSwitchedMsg resultado de cambiar el tema desde el menú
+type ThemeSwitchedMsg struct {
+	Theme actions.Theme
+	Err   error
+}
+
+// RenderThemePreview renderiza una muestra del tema: colores ANSI, prompt y código
+func RenderThemePreview(theme actions.Theme) string {
+	p := theme.TerminalPalette
+	bg := lipgloss.Color(p.Background)
+
+	// seg colorea un fragmento sobre el fondo del tema
+	seg := func(color, text string) string {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Background(bg).Render(text)
+	}
+	bold := func(color, text string) string {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Background(bg).Bold(true).Render(text)
+	}
+	swatches := func(colors []string) string {
+		var b strings.Builder
+		for _, c := range colors {
+			b.WriteString(lipgloss.NewStyle().Background(lipgloss.Color(c)).Render("   "))
+			b.WriteString(seg(p.Foreground, " "))
+		}
+		return b.String()
+	}
+
+	lines := []string{
+		bold(p.Foreground, p.Name) + seg(p.Bright.Black, "  "+theme.Variant),
+		"",
+		swatches(p.Normal.List()),
+		swatches(p.Bright.List()),
+		"",
+		bold(p.Normal.Blue, "xebec") + seg(p.Foreground, " in ") + bold(p.Normal.Cyan, "~/dots") +
+			seg(p.Foreground, " on ") + bold(p.Normal.Magenta, " main") + seg(p.Normal.Red, " [!]"),
+		bold(p.Normal.Green, "❯ ") + seg(p.Foreground, "ls -l") +
+			lipgloss.NewStyle().Background(lipgloss.Color(p.Cursor)).Render(" "),
+		bold(p.Normal.Blue, "assets/  ") + bold(p.Normal.Green, "install.sh  ") +
+			seg(p.Normal.Cyan, "config -> ~/.config  ") + seg(p.Foreground, "README.md"),
+		"",
+		seg(p.Bright.Black, "// saludo devuelve el mensaje de bienvenida"),
+		bold(p.Normal.Blue, "func ") + seg(p.Normal.Cyan, "saludo") + seg(p.Foreground, "(n ") +
+			seg(p.Bright.Yellow, "int") + seg(p.Foreground, ") ") + seg(p.Bright.Yellow, "string") + seg(p.Foreground, " {"),
+		seg(p.Foreground, "    ") + bold(p.Normal.Blue, "return ") + seg(p.Foreground, "fmt.") + seg(p.Normal.Cyan, "Sprintf") +
+			seg(p.Foreground, "(") + seg(p.Normal.Green, `"hola %d"`) + seg(p.Foreground, ", n ") +
+			seg(p.Normal.Yellow, "+") + seg(p.Bright.Magenta, " 42") + seg(p.Foreground, ")"),
+		seg(p.Foreground, "}"),
+		"",
+		lipgloss.NewStyle().Foreground(lipgloss.Color(p.Foreground)).Background(lipgloss.Color(p.Selection)).Render(" texto seleccionado "),
+	}
+
+	width := 0
+	for _, line := range lines {
+		width = max(width, lipgloss.Width(line))
+	}
+	padded := make([]string, len(lines))
+	for i, line := range lines {
+		padded[i] = line + seg(p.Foreground, strings.Repeat(" ", width-lipgloss.Width(line)))
+	}
+
+	return lipgloss.NewStyle().
+		Background(bg).
+		Padding(1, 2).
+		BorderStyle(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color(theme.UI.Primary)).
+		Render(strings.Join(padded, "\n"))
+}
+
+// RenderThemeIssues lista los problemas de legibilidad de un tema
+func RenderThemeIssues(issues []actions.ThemeIssue) string {
+	if len(issues) == 0 {
+		return SuccessStyle.Render("✓ Contraste WCAG y daltonismo sin problemas")
+	}
+	lines := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		if issue.Severity == actions.LintError {
+			lines = append(lines, ErrorStyle.Render("✗ ")+NormalTextStyle.Render(issue.String()))
+		} else {
+			lines = append(lines, WarningStyle.Render("⚠ ")+NormalTextStyle.Render(issue.String()))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// startThemeMode abre el gestor de temas con el tema activo seleccionado
+func (m *MenuModel) startThemeMode() {
+	list, err := actions.ListThemes()
+	if err != nil {
+		fmt.Println(ErrorStyle.Render(fmt.Sprintf("✗ Error: %v", err)))
+		return
+	}
+	state, err := actions.LoadState()
+	if err != nil {
+		fmt.Println(WarningStyle.Render(fmt.Sprintf("⚠ %v", err)))
+	}
+
+	m.IsThemeMode = true
+	m.ThemeList = list
+	m.ThemeAppearance = state.Appearance
+	m.ThemeMessage = ""
+	m.Selected = 0
+	for i, t := range list {
+		if t.Slug == state.Theme {
+			m.Selected = i
+		}
+	}
+}
+
+// updateThemeMode maneja las teclas del gestor de temas
+func (m MenuModel) updateThemeMode(msg tea.Msg) (MenuModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "k":
+			if m.Selected > 0 {
+				m.Selected--
+			}
+		case "down", "j":
+			if m.Selected < len(m.ThemeList)-1 {
+				m.Selected++
+			}
+		case "a", "tab":
+			// Rotar apariencia: dark -> light -> auto
+			for i, a := range actions.Appearances {
+				if a == m.ThemeAppearance {
+					m.ThemeAppearance = actions.Appearances[(i+1)%len(actions.Appearances)]
+					break
+				}
+			}
+		case "enter", " ":
+			if m.Selected >= len(m.ThemeList) {
+				return m, nil
+			}
+			slug, appearance := m.ThemeList[m.Selected].Slug, m.ThemeAppearance
+			m.ThemeMessage = "Aplicando tema..."
+			return m, func() tea.Msg {
+				theme, err := actions.SwitchTheme(slug, appearance)
+				return ThemeSwitchedMsg{Theme: theme, Err: err}
+			}
+		case "q", "esc", "left", "backspace", "ctrl+c":
+			m.IsThemeMode = false
+			m.ThemeList = nil
+			m.ThemeMessage = ""
+			m.Selected = 0
+		}
+
+	case ThemeSwitchedMsg:
+		if msg.Theme.Name != "" {
+			ApplyTheme(msg.Theme)
+		}
+		if msg.Err != nil {
+			m.ThemeMessage = ErrorStyle.Render(fmt.Sprintf("✗ %v", msg.Err))
+		} else {
+			m.ThemeMessage = SuccessStyle.Render(fmt.Sprintf("✅ Tema %s (%s) aplicado", msg.Theme.Name, msg.Theme.Variant))
+		}
+
+	case tea.WindowSizeMsg:
+		m.Width = msg.Width
+		m.Height = msg.Height
+	}
+
+	return m, nil
+}
+
+// renderThemeView renderiza la lista de temas junto a la vista previa del seleccionado
+func (m MenuModel) renderThemeView() string {
+	var b strings.Builder
+
+	width := m.Width
+	if width == 0 {
+		width = 80
+	}
+	contentWidth := width - 4
+	if contentWidth < 60 {
+		contentWidth = 60
+	}
+
+	b.WriteString(TitleStyle.Width(contentWidth).Align(lipgloss.Center).Render("🎨 Temas"))
+	b.WriteString("\n\n")
+
+	state, _ := actions.LoadState()
+	selectedStyle := lipgloss.NewStyle().
+		Background(lipgloss.Color(BrandingConfig.Colors.Primary)).
+		Foreground(lipgloss.Color(BrandingConfig.Colors.White))
+
+	var list strings.Builder
+	for i, t := range m.ThemeList {
+		marker := "  "
+		if t.Slug == state.Theme {
+			marker = "● "
+		}
+		line := fmt.Sprintf("%s%-18s", marker, t.Name)
+		if i == m.Selected {
+			list.WriteString(selectedStyle.Render(line))
+		} else {
+			list.WriteString(NormalTextStyle.Render(line))
+		}
+		list.WriteString("\n")
+		list.WriteString(MutedTextStyle.Render("    " + strings.Join(t.Variants, " · ")))
+		list.WriteString("\n")
+	}
+
+	appearance := m.ThemeAppearance
+	if appearance == actions.AppearanceAuto {
+		if detected, ok := actions.DetectOSAppearance(); ok {
+			appearance += " → " + detected
+		} else {
+			appearance += " (no detectada)"
+		}
+	}
+	list.WriteString("\n")
+	list.WriteString(HighlightStyle.Render("Apariencia: ") + NormalTextStyle.Render(appearance))
+
+	preview, issues := "", ""
+	if m.Selected < len(m.ThemeList) {
+		theme, err := actions.ResolveTheme(m.ThemeList[m.Selected].Slug, m.ThemeAppearance)
+		if err != nil {
+			preview = ErrorStyle.Render(fmt.Sprintf("✗ %v", err))
+		} else {
+			preview = RenderThemePreview(theme)
+			issues = RenderThemeIssues(actions.LintTheme(theme))
+		}
+	}
+
+	b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, list.String(), "   ", preview))
+	b.WriteString("\n\n")
+	if issues != "" {
+		b.WriteString(issues)
+		b.WriteString("\n\n")
+	}
+
+	if m.ThemeMessage != "" {
+		b.WriteString(m.ThemeMessage)
+		b.WriteString("\n\n")
+	}
+
+	b.WriteString(MutedTextStyle.Render("Enter: aplicar │ a: apariencia │ ↑↓: navegar │ q: volver"))
+
+	return b.String()
+}
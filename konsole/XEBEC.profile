@@ -0,0 +1,30 @@
+# ============================================
+#  KONSOLE – XEBEC CORPORATION
+#  Generado por xebec
+# ============================================
+
+[General]
+Name=XEBEC
+Parent=FALLBACK/
+{{- if .Modules.window }}
+TerminalMargin=0
+{{- end }}{{ if .Command }}
+Command={{ .Command }}
+{{- end }}
+{{ if or .Modules.colors .Modules.font }}
+[Appearance]
+{{- if .Modules.colors }}
+ColorScheme=XEBEC
+{{- end }}{{ if .Modules.font }}
+Font=JetBrains Mono,13,-1,5,50,0,0,0,0,0
+{{- end }}
+{{ end }}{{ if .Modules.window }}
+[Scrolling]
+ScrollBarPosition=2
+{{ end }}{{ if .Modules.cursor }}
+[Cursor Options]
+CursorShape=1
+
+[Terminal Features]
+BlinkingCursorEnabled=true
+{{ end -}}
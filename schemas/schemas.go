@@ -0,0 +1,13 @@
+// Package: schemas
+// Opciones conocidas de cada herramienta para validar su configuración antes de escribirla
+// author: XebecCorporation
+// version: 1.0.0
+
+package schemas
+
+import "embed"
+
+// Builtin esquemas incluidos en el binario, uno por herramienta (<id>.json)
+//
+//go:embed *.json
+var Builtin embed.FS
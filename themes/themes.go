@@ -0,0 +1,16 @@
+// Package: themes
+// Temas integrados de XEBEC (fuente única de colores)
+// author: XebecCorporation
+// version: 1.0.0
+
+package themes
+
+import "embed"
+
+// Builtin temas incluidos en el binario; xebec.json es el tema por defecto
+//
+//go:embed *.json
+var Builtin embed.FS
+
+// Default nombre del archivo del tema por defecto
+const Default = "xebec.json"
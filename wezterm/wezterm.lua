@@ -0,0 +1,11 @@
+-- ============================================
+--  WEZTERM – XEBEC CORPORATION
+--  Creado por xebec: la configuración XEBEC vive en xebec.lua
+-- ============================================
+
+local wezterm = require 'wezterm'
+local config = wezterm.config_builder()
+
+-- Tus ajustes aquí
+
+return config
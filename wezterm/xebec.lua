@@ -0,0 +1,66 @@
+-- ============================================
+--  WEZTERM – XEBEC CORPORATION
+{{- if .ModulePath }}
+--  Generado por xebec: {{ .ModulePath }}
+{{- end }}
+-- ============================================
+
+local wezterm = require 'wezterm'
+local M = {}
+
+function M.apply_to_config(config)
+  config.colors = config.colors or {}
+{{ if .Modules.window }}
+  -- Ventana sin bordes, transparente y maximizada
+  config.window_decorations = 'RESIZE'
+  config.window_background_opacity = 0.85
+  config.window_padding = { left = 0, right = 0, top = 0, bottom = 0 }
+  wezterm.on('gui-startup', function(cmd)
+    local _, _, window = wezterm.mux.spawn_window(cmd or {})
+    window:gui_window():maximize()
+  end)
+{{ end }}{{ if .Modules.colors }}
+  -- Tema {{ .Palette.Name }}
+  config.colors.foreground = '{{ .Palette.Foreground }}'
+  config.colors.background = '{{ .Palette.Background }}'
+  config.colors.cursor_bg = '{{ .Palette.Cursor }}'
+  config.colors.cursor_fg = '{{ .Palette.Background }}'
+  config.colors.cursor_border = '{{ .Palette.Cursor }}'
+  config.colors.selection_bg = '{{ .Palette.Selection }}'
+  config.colors.selection_fg = '{{ .Palette.Foreground }}'
+  config.colors.ansi = { {{ range $i, $c := .Palette.Normal.List }}{{ if $i }}, {{ end }}'{{ $c }}'{{ end }} }
+  config.colors.brights = { {{ range $i, $c := .Palette.Bright.List }}{{ if $i }}, {{ end }}'{{ $c }}'{{ end }} }
+{{ end }}{{ if .Modules.font }}
+  -- Fuente
+  config.font = wezterm.font_with_fallback { 'JetBrains Mono', 'Symbols Nerd Font Mono' }
+  config.font_size = 13.0
+{{ end }}{{ if .Modules.cursor }}
+  -- Cursor
+  config.default_cursor_style = 'BlinkingBar'
+  config.cursor_blink_rate = 500
+{{ end }}{{ if .Modules.shell }}
+  -- Shell
+{{- if .Program }}
+  config.default_prog = { {{ .Program }}{{ range .Args }}, '{{ . }}'{{ end }} }
+{{- else }}
+  -- Nushell no está instalado: se usa el shell por defecto del sistema
+{{- end }}
+{{ end }}{{ if .Modules.tab_bar }}
+  -- Barra de pestañas
+  config.use_fancy_tab_bar = false
+  config.tab_bar_at_bottom = true
+  config.hide_tab_bar_if_only_one_tab = true
+  config.tab_max_width = 32
+  config.colors.tab_bar = {
+    background = '{{ .Palette.Background }}',
+    active_tab = { bg_color = '{{ .Palette.Normal.Blue }}', fg_color = '{{ .Palette.Background }}', intensity = 'Bold' },
+    inactive_tab = { bg_color = '{{ .Palette.Normal.Black }}', fg_color = '{{ .Palette.Bright.Black }}' },
+    inactive_tab_hover = { bg_color = '{{ .Palette.Selection }}', fg_color = '{{ .Palette.Foreground }}' },
+    new_tab = { bg_color = '{{ .Palette.Background }}', fg_color = '{{ .Palette.Bright.Black }}' },
+    new_tab_hover = { bg_color = '{{ .Palette.Selection }}', fg_color = '{{ .Palette.Normal.Blue }}' },
+  }
+{{ end }}
+  return config
+end
+
+return M